@@ -0,0 +1,324 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+// Go representations of thrift sets, which is specified by option
+// Generator.SetType.
+const (
+	SetMap   = "map"   // map[T]bool
+	SetSlice = "slice" // []T, which preserves the wire order
+	SetTyped = "typed" // typed set, e.g. thrift.StringSet, PointSet
+)
+
+// Structs used as map keys or set elements are represented by value
+// instead of pointer, so they are compared by fields, which requires all
+// fields of the struct be comparable and not pointers, i.e. required or
+// default fields of base types (except binary) and enums. Structs which
+// preserve unknown fields are never comparable. Other structs are
+// represented by keys of their binary encoding, e.g. type PointKey string,
+// which requires that the structs have no maps or sets, whose encoding is
+// not canonical. Typed sets of such keys fall back to sets represented as
+// maps.
+
+// checkContainers checks that the key types of maps and the element types
+// of sets are comparable or encoded keys, which is not required for sets
+// represented as slices. It records the structs used as encoded keys.
+func (g *Generator) checkContainers() error {
+	switch g.SetType {
+	case SetMap, SetSlice, SetTyped:
+	default:
+		return fmt.Errorf("unknown set type: %v", g.SetType)
+	}
+	pkgs := []*Package{g.RootPkg}
+	for _, pkg := range g.ImportedPkgs {
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		for _, x := range pkg.Typedefs {
+			if err := g.checkKeys("typedef "+x.Alias, x.Type); err != nil {
+				return err
+			}
+		}
+		for _, x := range pkg.Constants {
+			if err := g.checkKeys("const "+x.Name, x.Type); err != nil {
+				return err
+			}
+		}
+		var structs []*parser.Struct
+		structs = append(structs, pkg.Structs...)
+		structs = append(structs, pkg.Exceptions...)
+		for _, x := range pkg.Unions {
+			structs = append(structs, (*parser.Struct)(x))
+		}
+		for _, s := range structs {
+			for _, f := range s.Fields {
+				if err := g.checkKeys(s.Name+"."+f.Name, f.Type); err != nil {
+					return err
+				}
+			}
+		}
+		for _, svc := range pkg.Services {
+			for _, m := range svc.Methods {
+				for _, f := range m.Arguments {
+					if err := g.checkKeys(svc.Name+"."+m.Name+"."+f.Name, f.Type); err != nil {
+						return err
+					}
+				}
+				if m.ReturnType != nil {
+					if err := g.checkKeys(svc.Name+"."+m.Name, m.ReturnType); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) checkKeys(where string, typ *parser.Type) error {
+	if typ.Category != parser.TypeContainer {
+		return nil
+	}
+	switch typ.Name {
+	case "map":
+		if g.isEncodedKey(typ.KeyType) {
+			if err := g.addKeyStruct(typ.KeyType); err != nil {
+				return fmt.Errorf("%v: map key type %v is not comparable: %v", where, typ.KeyType, err)
+			}
+		} else if !g.isComparable(typ.KeyType) {
+			return fmt.Errorf("%v: map key type %v is not comparable", where, typ.KeyType)
+		}
+		if err := g.checkKeys(where, typ.KeyType); err != nil {
+			return err
+		}
+	case "set":
+		if g.SetType == SetSlice {
+			break
+		}
+		if g.isEncodedKey(typ.ValueType) {
+			if err := g.addKeyStruct(typ.ValueType); err != nil {
+				return fmt.Errorf("%v: set element type %v is not comparable: %v, try set type %q", where, typ.ValueType, err, SetSlice)
+			}
+		} else if !g.isComparable(typ.ValueType) {
+			return fmt.Errorf("%v: set element type %v is not comparable, try set type %q", where, typ.ValueType, SetSlice)
+		}
+	}
+	return g.checkKeys(where, typ.ValueType)
+}
+
+// isEncodedKey tells whether struct typ is represented by the key of its
+// encoding when it is used as map keys or set elements.
+func (g *Generator) isEncodedKey(typ *parser.Type) bool {
+	return g.isPtrType(typ) && !g.isComparable(typ)
+}
+
+// addKeyStruct records struct typ to generate the key type for it, it
+// fails if typ contains maps or sets.
+func (g *Generator) addKeyStruct(typ *parser.Type) error {
+	s := finalStruct(typ)
+	if s == nil {
+		return fmt.Errorf("unknown struct %v", typ.Name)
+	}
+	if err := g.checkCanonical(s, make(map[*parser.Struct]bool)); err != nil {
+		return err
+	}
+	if g.keyStructs == nil {
+		g.keyStructs = make(map[*parser.Struct]bool)
+	}
+	g.keyStructs[s] = true
+	return nil
+}
+
+// checkCanonical checks that the binary encoding of s is canonical, i.e.
+// s and its nested structs have no maps or sets.
+func (g *Generator) checkCanonical(s *parser.Struct, seen map[*parser.Struct]bool) error {
+	if seen[s] {
+		return nil
+	}
+	seen[s] = true
+	var check func(typ *parser.Type) error
+	check = func(typ *parser.Type) error {
+		if g.isPtrType(typ) {
+			if x := finalStruct(typ); x != nil {
+				return g.checkCanonical(x, seen)
+			}
+			return nil
+		}
+		typ = g.underlyingType(typ)
+		if typ.Category != parser.TypeContainer {
+			return nil
+		}
+		if typ.Name != "list" {
+			return fmt.Errorf("%v has %v fields", s.Name, typ.Name)
+		}
+		return check(typ.ValueType)
+	}
+	for _, f := range s.Fields {
+		if err := check(f.Type); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finalStruct returns the struct, union or exception which typ refers to.
+func finalStruct(typ *parser.Type) *parser.Struct {
+	switch x := typ.GetFinalType().(type) {
+	case *parser.Struct:
+		return x
+	case *parser.Union:
+		return (*parser.Struct)(x)
+	}
+	return nil
+}
+
+// formatKeyType returns the Go type of typ used as map keys or set
+// elements, which is the key type of encoded keys.
+func (g *Generator) formatKeyType(typ *parser.Type) (string, error) {
+	name, err := g.formatType(typ)
+	if err != nil || !g.isEncodedKey(typ) {
+		return name, err
+	}
+	return name + "Key", nil
+}
+
+// setKind returns the Go representation of set typ, which is option
+// Generator.SetType, or SetMap for typed sets of encoded keys.
+func (g *Generator) setKind(typ *parser.Type) string {
+	if g.SetType == SetTyped && g.isEncodedKey(typ.ValueType) {
+		return SetMap
+	}
+	return g.SetType
+}
+
+// isComparable tells whether values of typ can be used as map keys.
+func (g *Generator) isComparable(typ *parser.Type) bool {
+	typ = g.underlyingType(typ)
+	switch typ.Category {
+	case parser.TypeBasic:
+		return typ.TType() != parser.BINARY
+	case parser.TypeIdentifier:
+		switch x := typ.GetFinalType().(type) {
+		case *parser.Enum:
+			return true
+		case *parser.Struct:
+			return g.isComparableStruct(x)
+		}
+	}
+	return false
+}
+
+func (g *Generator) isComparableStruct(s *parser.Struct) bool {
+	if g.KeepUnknownFields {
+		return false
+	}
+	for _, f := range s.Fields {
+		if g.isPtrField(f) {
+			return false
+		}
+		typ := g.underlyingType(f.Type)
+		if typ.Category == parser.TypeContainer || typ.TType() == parser.BINARY {
+			return false
+		}
+	}
+	return true
+}
+
+// setTypeName returns the typed set of elem, which is a set type defined
+// in package thrift for base types, or the one generated along with the
+// enum, struct or defined typedef.
+func (g *Generator) setTypeName(elem *parser.Type) (string, error) {
+	// set of an alias is the set of the aliased type
+	elem = g.resolveAlias(elem)
+	name, err := g.formatType(elem)
+	if err != nil {
+		return "", err
+	}
+	if elem.Category == parser.TypeBasic {
+		return "thrift." + strings.ToUpper(name[:1]) + name[1:] + "Set", nil
+	}
+	return name + "Set", nil
+}
+
+// resolveAlias follows the alias typedefs of typ, it returns the aliased
+// type, or typ itself if typ is not an alias.
+func (g *Generator) resolveAlias(typ *parser.Type) *parser.Type {
+	for typ.Category == parser.TypeIdentifier {
+		td := g.lookupTypedef(typ)
+		if td == nil || (!g.isPtrType(typ) && g.isDefinedTypedef(td)) {
+			break
+		}
+		typ = td.Type
+	}
+	return typ
+}
+
+// lookupTypedef returns the typedef which typ refers to, or nil if typ
+// does not refer to a typedef.
+func (g *Generator) lookupTypedef(typ *parser.Type) *parser.Typedef {
+	doc, name := typ.D, typ.Name
+	if doc == nil {
+		return nil
+	}
+	if parts := strings.SplitN(name, ".", 2); len(parts) == 2 {
+		inc := doc.Includes[parts[0]]
+		if inc == nil || g.ImportedPkgs[inc.AbsPath] == nil {
+			return nil
+		}
+		doc, name = g.ImportedPkgs[inc.AbsPath].Document, parts[1]
+	}
+	for _, td := range doc.Typedefs {
+		if td.Alias == name {
+			return td
+		}
+	}
+	return nil
+}
+
+// SetTypes returns the element types of the typed sets to be generated
+// along with the types of the package, which are the enums, the comparable
+// structs and the defined typedefs of comparable types.
+func (p *Package) SetTypes() []string {
+	if p.G.SetType != SetTyped {
+		return nil
+	}
+	var r []string
+	for _, x := range p.Typedefs {
+		if x.Type.Category != parser.TypeContainer && !p.G.isPtrType(x.Type) &&
+			p.G.isDefinedTypedef(x) && p.G.isComparable(x.Type) {
+			r = append(r, x.Alias)
+		}
+	}
+	for _, x := range p.Enums {
+		r = append(r, ToCamelCase(x.Name))
+	}
+	// exceptions have been appended to structs
+	for _, x := range p.Structs {
+		if p.G.isComparableStruct(x) {
+			r = append(r, ToCamelCase(x.Name))
+		}
+	}
+	return r
+}
+
+// KeyTypes returns the names of the structs of the package which are used
+// as encoded keys, whose key types are generated along with them.
+func (p *Package) KeyTypes() []string {
+	var r []string
+	for _, x := range p.Structs {
+		if p.G.keyStructs[x] {
+			r = append(r, ToCamelCase(x.Name))
+		}
+	}
+	for _, x := range p.Unions {
+		if p.G.keyStructs[(*parser.Struct)(x)] {
+			r = append(r, ToCamelCase(x.Name))
+		}
+	}
+	return r
+}
@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+// Annotations to customize the generated fields:
+//
+//	go.name    name of the Go field, e.g. "UserID"
+//	go.tag     extra struct tags, e.g. 'db:"user_id"', a json tag replaces
+//	           the generated one
+//	go.type    Go type of a basic or enum field, e.g. "time.Duration" or
+//	           "github.com/foo/bar.Type", which is converted from and to
+//	           the wire type by Go conversion, unless hooks are given
+//	go.from    function converting the wire value to go.type
+//	go.to      function converting go.type to the wire value
+//
+// The wire format is never changed by the annotations.
+
+type customImport struct {
+	Name       string
+	ImportPath string
+	Use        string // statement referencing the import to suppress errors
+}
+
+// CustomImports returns the packages referenced by annotation "go.type",
+// "go.from" and "go.to" of the package.
+func (p *Package) CustomImports() []customImport {
+	var r []customImport
+	for _, x := range p.customImports {
+		r = append(r, x)
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].ImportPath < r[j].ImportPath
+	})
+	return r
+}
+
+// fieldName returns name of the Go field, which can be specified by
+// annotation "go.name".
+func (g *Generator) fieldName(field *parser.Field) string {
+	if name, ok := parser.GetAnnotation(field.Annotations, "go.name"); ok {
+		return name
+	}
+	return ToCamelCase(field.Name)
+}
+
+// customizeTypes replaces types of the fields annotated by "go.type" with
+// identifier types, which are resolved to the original types, so they are
+// read and written the same way as typedefs.
+func (g *Generator) customizeTypes() error {
+	pkgs := []*Package{g.RootPkg}
+	for _, pkg := range g.ImportedPkgs {
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		var fields []*parser.Field
+		for _, s := range pkg.Structs {
+			fields = append(fields, s.Fields...)
+		}
+		for _, s := range pkg.Exceptions {
+			fields = append(fields, s.Fields...)
+		}
+		for _, s := range pkg.Unions {
+			fields = append(fields, s.Fields...)
+		}
+		for _, svc := range pkg.Services {
+			for _, m := range svc.Methods {
+				fields = append(fields, m.Arguments...)
+			}
+		}
+		for _, f := range fields {
+			if err := g.customizeField(pkg, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) customizeField(pkg *Package, field *parser.Field) error {
+	goType, ok := parser.GetAnnotation(field.Annotations, "go.type")
+	if !ok {
+		for _, name := range []string{"go.from", "go.to"} {
+			if _, ok := parser.GetAnnotation(field.Annotations, name); ok {
+				return fmt.Errorf("field %v: annotation %v requires go.type", field.Name, name)
+			}
+		}
+		return nil
+	}
+	var final interface{} = field.Type
+	if field.Type.Category == parser.TypeIdentifier {
+		final = field.Type.GetFinalType()
+	}
+	switch x := final.(type) {
+	case *parser.Enum:
+	case *parser.Type:
+		if x.Category != parser.TypeBasic {
+			return fmt.Errorf("field %v: go.type is not applicable to type %v", field.Name, field.Type)
+		}
+	default:
+		return fmt.Errorf("field %v: go.type is not applicable to type %v", field.Name, field.Type)
+	}
+
+	name := pkg.customRef(goType, true)
+	var hooks []*parser.Annotation
+	for _, hook := range []string{"go.from", "go.to"} {
+		if fn, ok := parser.GetAnnotation(field.Annotations, hook); ok {
+			if _, ok := final.(*parser.Enum); ok {
+				return fmt.Errorf("field %v: %v is not applicable to enum", field.Name, hook)
+			}
+			fn = pkg.customRef(fn, false)
+			hooks = append(hooks, &parser.Annotation{Name: hook, Value: fn})
+		}
+	}
+	field.Type = &parser.Type{
+		Name:        name,
+		Category:    parser.TypeIdentifier,
+		Annotations: hooks,
+		D:           pkg.Document,
+		FinalType:   final,
+	}
+	return nil
+}
+
+// customRef registers the package of ref, which is a type or function
+// qualified by import path, e.g. "github.com/foo/bar.Type", and returns
+// the reference qualified by package name, e.g. "bar.Type".
+func (p *Package) customRef(ref string, isType bool) string {
+	slash := strings.LastIndex(ref, "/")
+	dot := strings.Index(ref[slash+1:], ".")
+	if dot < 0 {
+		return ref
+	}
+	importPath := ref[:slash+1+dot]
+	name := path.Base(importPath)
+	ref = name + ref[slash+1+dot:]
+	if p.customImports == nil {
+		p.customImports = make(map[string]customImport)
+	}
+	if _, ok := p.customImports[importPath]; !ok {
+		use := "_ = " + ref
+		if isType {
+			use = "_ " + ref
+		}
+		p.customImports[importPath] = customImport{Name: name, ImportPath: importPath, Use: use}
+	}
+	return ref
+}
+
+// customTag returns the extra struct tags specified by annotation "go.tag",
+// and whether it contains a json tag.
+func customTag(field *parser.Field) (tag string, hasJSON bool) {
+	tag, ok := parser.GetAnnotation(field.Annotations, "go.tag")
+	if !ok {
+		return "", false
+	}
+	_, hasJSON = reflect.StructTag(tag).Lookup("json")
+	return tag, hasJSON
+}
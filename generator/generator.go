@@ -6,6 +6,7 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -21,7 +22,7 @@ var (
 	logger = log.New(os.Stderr, "[THRIFTERC] ", log.LstdFlags)
 	//pprint  = spew.ConfigState{DisableMethods: true, Indent: "  "}
 	tmplBox    = packr.NewBox("./templates")
-	mlineRegex = regexp.MustCompile(`([\r\n]+\s*){2,}([\w\}\]\)/'"]+)`)
+	mlineRegex = regexp.MustCompile(`(\r?\n\s*){2,}([\w\}\]\)/'"]+)`)
 )
 
 var GitRevision = "????"
@@ -37,6 +38,21 @@ type Generator struct {
 	RootPkg      *Package
 	ImportedPkgs map[string]*Package // key: absolute path to thrift file
 
+	// DefinedTypedefs makes typedefs generated as defined types instead
+	// of type aliases, which can be overridden by annotation "go.defined".
+	DefinedTypedefs bool
+
+	// SetType specifies Go representation of thrift sets, one of SetMap,
+	// SetSlice and SetTyped.
+	SetType string
+
+	// KeepUnknownFields makes the generated structs preserve the unknown
+	// fields when reading, which are written back when writing.
+	KeepUnknownFields bool
+
+	// structs used as encoded map keys or set elements
+	keyStructs map[*parser.Struct]bool
+
 	tmplCache sync.Map
 }
 
@@ -46,6 +62,7 @@ func New(filename, prefix, output string) *Generator {
 		Prefix:       prefix,
 		Output:       output,
 		ImportedPkgs: make(map[string]*Package),
+		SetType:      SetMap,
 	}
 	return gen
 }
@@ -65,6 +82,13 @@ func (g *Generator) Parse() error {
 	if err = g.resolveTypes(); err != nil {
 		return err
 	}
+	g.normalizeUnions()
+	if err = g.customizeTypes(); err != nil {
+		return err
+	}
+	if err = g.checkContainers(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -99,6 +123,25 @@ func (g *Generator) parseIncludes() error {
 	return nil
 }
 
+// normalizeUnions makes fields of unions optional.
+func (g *Generator) normalizeUnions() {
+	pkgs := []*Package{g.RootPkg}
+	for _, pkg := range g.ImportedPkgs {
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		for _, un := range pkg.Unions {
+			for _, f := range un.Fields {
+				if f.Requiredness != parser.ReqDefault {
+					log.Printf("union %v field %v: union members must be optional, ignoring specified requiredness\n", un.Name, f.Name)
+					f.Requiredness = parser.ReqDefault
+				}
+				f.Optional = true
+			}
+		}
+	}
+}
+
 func (g *Generator) resolveTypes() error {
 	pkgs := []*Package{g.RootPkg}
 	for _, pkg := range g.ImportedPkgs {
@@ -199,9 +242,26 @@ func (g *Generator) funcMap() template.FuncMap {
 		"formatStructTag": g.formatStructTag,
 		"formatReturn":    g.formatReturn,
 		"formatArguments": g.formatArguments,
+		"formatNew":       g.formatNew,
 		"formatRead":      g.formatRead,
 		"formatWrite":     g.formatWrite,
+		"formatReadKey":   g.formatReadKey,
+		"copyField":       g.copyField,
+		"equalsField":     g.equalsField,
+		"isPtrValueField": g.isPtrValueField,
+		"validateField":   g.validateField,
 		"reqChecker":      g.reqChecker,
+		"hashKeyField":    g.hashKeyField,
+		"isIdempotent":    g.isIdempotent,
+		"checkUnion":      g.checkUnion,
+		"messageField":    g.messageField,
+		"jsonName":        g.jsonName,
+		"definedTypedef":  g.isDefinedTypedef,
+		"fieldName":       g.fieldName,
+		"setType":         g.setKind,
+		"formatKeyType":   g.formatKeyType,
+		"formatWriteKey":  g.formatWriteKey,
+		"keepUnknown":     func() bool { return g.KeepUnknownFields },
 		"toCamelCase":     ToCamelCase,
 		"toSnakeCase":     ToSnakeCase,
 		"TODO":            func() string { return "TODO" },
@@ -235,49 +295,6 @@ func (g *Generator) isPtrType(typ *parser.Type) bool {
 	return false
 }
 
-func (g *Generator) formatValue(value interface{}) (string, error) {
-	switch v := value.(type) {
-	case parser.ConstValue:
-		switch v.Type {
-		case parser.ConstTypeLiteral:
-			return fmt.Sprintf("%q", v.Value), nil
-		case parser.ConstTypeIdentifier:
-			ss := strings.Split(v.Value, ".")
-			if len(ss) <= 2 {
-				return v.Value, nil
-			}
-			return strings.Join(ss[0:len(ss)-1], ".") + "_" + ss[len(ss)-1], nil
-		}
-		return v.Value, nil
-	case parser.ListConstValue:
-		values := make([]string, len(v))
-		for i, item := range v {
-			v, vErr := g.formatValue(item)
-			if vErr != nil {
-				return "", vErr
-			}
-			values[i] = v
-		}
-		return fmt.Sprintf("{%v}", strings.Join(values, ", ")), nil
-	case []parser.MapConstValue:
-		kvs := make([]string, len(v))
-		for i, item := range v {
-			k, kErr := g.formatValue(item.Key)
-			if kErr != nil {
-				return "", kErr
-			}
-			v, vErr := g.formatValue(item.Value)
-			if vErr != nil {
-				return "", vErr
-			}
-			kvs[i] = fmt.Sprintf("%v: %v", k, v)
-		}
-		return fmt.Sprintf("{%v}", strings.Join(kvs, ", ")), nil
-	default:
-		return "", fmt.Errorf("undefined const type: %t", v)
-	}
-}
-
 func (g *Generator) formatType(typ *parser.Type) (string, error) {
 	if typ.Category == parser.TypeBasic {
 		switch typ.Name {
@@ -324,12 +341,24 @@ func (g *Generator) formatType(typ *parser.Type) (string, error) {
 		var err error
 		switch typ.Name {
 		case "set":
-			if kt, err = g.formatType(typ.ValueType); err != nil {
+			switch g.setKind(typ) {
+			case SetSlice:
+				if vt, err = g.formatType(typ.ValueType); err != nil {
+					return "", err
+				}
+				if g.isPtrType(typ.ValueType) {
+					vt = "*" + vt
+				}
+				return fmt.Sprintf("[]%v", vt), nil
+			case SetTyped:
+				return g.setTypeName(typ.ValueType)
+			}
+			if kt, err = g.formatKeyType(typ.ValueType); err != nil {
 				return "", err
 			}
 			return fmt.Sprintf("map[%v]bool", kt), nil
 		case "map":
-			if kt, err = g.formatType(typ.KeyType); err != nil {
+			if kt, err = g.formatKeyType(typ.KeyType); err != nil {
 				return "", err
 			}
 			if vt, err = g.formatType(typ.ValueType); err != nil {
@@ -381,12 +410,19 @@ func (g *Generator) formatStructTag(field *parser.Field) (str string, err error)
 	} else if field.Type.TType() == parser.SET {
 		must(buf.WriteString(",set"))
 	}
-	must(buf.WriteString(`" json:"`))
-	must(buf.WriteString(ToSnakeCase(field.Name)))
-	if field.Optional {
-		must(buf.WriteString(",omitempty"))
-	}
 	must(buf.WriteRune('"'))
+	tag, hasJSON := customTag(field)
+	if !hasJSON {
+		must(buf.WriteString(` json:"`))
+		must(buf.WriteString(ToSnakeCase(field.Name)))
+		if field.Optional {
+			must(buf.WriteString(",omitempty"))
+		}
+		must(buf.WriteRune('"'))
+	}
+	if tag != "" {
+		must(buf.WriteString(" " + tag))
+	}
 	return buf.String(), nil
 }
 
@@ -410,6 +446,7 @@ func (g *Generator) formatArguments(svc *parser.Service) (string, error) {
 	}
 	pkg := &Package{
 		Document: &parser.Document{
+			Filename: svc.D.Filename,
 			RefName:  svc.D.RefName,
 			Includes: svc.D.Includes,
 			Structs:  argStructs,
@@ -472,6 +509,121 @@ func (g *Generator) parseArguments(svc *parser.Service) ([]*parser.Struct, error
 	return argStructs, nil
 }
 
+// hashKeyField returns the field annotated by "hash_key", which is used
+// as key of the consistent hashing load balancer. The key is formatted by
+// fmt.Sprint, so the field must be of base types or enums, whose values
+// are formatted instead of addresses.
+func (g *Generator) hashKeyField(fields []*parser.Field) (*parser.Field, error) {
+	for _, f := range fields {
+		if _, ok := parser.GetAnnotation(f.Annotations, "hash_key"); !ok {
+			continue
+		}
+		typ := g.underlyingType(f.Type)
+		if _, isEnum := typ.GetFinalType().(*parser.Enum); typ.Category != parser.TypeBasic && !isEnum {
+			return nil, fmt.Errorf("field %v: hash_key is not applicable to type %v", f.Name, f.Type)
+		}
+		return f, nil
+	}
+	return nil, nil
+}
+
+// isUnion tells whether s is a union, which may be converted from
+// *parser.Union to be executed by the struct templates.
+func (g *Generator) isUnion(s *parser.Struct) bool {
+	pkgs := []*Package{g.RootPkg}
+	for _, pkg := range g.ImportedPkgs {
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		for _, x := range pkg.Unions {
+			if (*parser.Struct)(x) == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkUnion returns statements which return a protocol error if union s
+// has not exactly one field set, it returns "" if s is not a union.
+// With option KeepUnknownFields, a single unknown field is considered as
+// a field of newer versions.
+func (g *Generator) checkUnion(s *parser.Struct) string {
+	if !g.isUnion(s) {
+		return ""
+	}
+	cond := "c != 1"
+	if g.KeepUnknownFields {
+		cond += " && !(c == 0 && len(p.UnknownFields) == 1)"
+	}
+	tmpl := `if c := p.CountSetFields(); %v { return thrift.NewApplicationException(thrift.INVALID_DATA, fmt.Sprintf("union %v must have exactly one field set, %%d set", c)) }`
+	return fmt.Sprintf(tmpl, cond, ToCamelCase(s.Name))
+}
+
+// jsonName returns the key of the field in JSON objects, which is "-" if
+// the field is ignored by encoding/json.
+func (g *Generator) jsonName(field *parser.Field) (string, error) {
+	tag, err := g.formatStructTag(field)
+	if err != nil {
+		return "", err
+	}
+	value := reflect.StructTag(tag).Get("json")
+	if value == "-" {
+		return value, nil
+	}
+	if name := strings.Split(value, ",")[0]; name != "" {
+		return name, nil
+	}
+	return g.fieldName(field), nil
+}
+
+// messageField returns the field of exception s which is used as the
+// error message, i.e. the field named by annotation "message" of the
+// exception, or the field named "message", which must be a string.
+func (g *Generator) messageField(s *parser.Struct) (*parser.Field, error) {
+	name, annotated := parser.GetAnnotation(s.Annotations, "message")
+	if !annotated {
+		name = "message"
+	}
+	f := findField(s, name)
+	if f == nil {
+		if annotated {
+			return nil, fmt.Errorf("exception %v: message field %v not found", s.Name, name)
+		}
+		return nil, nil
+	}
+	if g.underlyingType(f.Type).TType() != parser.STRING {
+		if annotated {
+			return nil, fmt.Errorf("exception %v: message field %v is not a string", s.Name, name)
+		}
+		return nil, nil
+	}
+	return f, nil
+}
+
+// isIdempotent tells whether the method is annotated by "idempotent",
+// which is safe to be retried by the kit client.
+func (g *Generator) isIdempotent(m *parser.Method) bool {
+	v, ok := parser.GetAnnotation(m.Annotations, "idempotent")
+	return ok && v != "false" && v != "0"
+}
+
+// isDefinedTypedef tells whether the typedef should be generated as a
+// defined type, e.g. "type UserID int64", which is not assignable to or
+// from other types without conversion. Typedefs of struct types are
+// always generated as aliases to keep the methods.
+func (g *Generator) isDefinedTypedef(td *parser.Typedef) bool {
+	defined := g.DefinedTypedefs
+	if v, ok := parser.GetAnnotation(td.Annotations, "go.defined"); ok {
+		defined = v != "false" && v != "0"
+	}
+	if defined && g.isPtrType(td.Type) {
+		logger.Printf("typedef %v: struct types can not be defined types, using type alias\n", td.Alias)
+		return false
+	}
+	return defined
+}
+
 func (g *Generator) reqChecker(s *parser.Struct) *ReqChecker {
 	requiredFields := make([]*parser.Field, 0)
 	for _, f := range s.Fields {
@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+const testdataPrefix = "github.com/jxskiss/thriftkit/generator/testdata"
+
+// testGenerated generates testdata/<name>.thrift, whose go namespace must
+// be name, and runs testdata/<name>_test.go against the generated package.
+// setup changes the options of the generator if it is not nil.
+func testGenerated(t *testing.T, name string, setup func(g *Generator)) {
+	if testing.Short() {
+		t.Skip("skipping generated code test in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	output := filepath.Join("testdata", "gen-"+name)
+	defer os.RemoveAll(output)
+	g := New(parser.AbsPath(filepath.Join("testdata", name+".thrift")), testdataPrefix+"/gen-"+name, parser.AbsPath(output))
+	if setup != nil {
+		setup(g)
+	}
+	if err = g.Parse(); err != nil {
+		t.Fatal("parse:", err)
+	}
+	if err = g.Generate(); err != nil {
+		t.Fatal("generate:", err)
+	}
+
+	test, err := ioutil.ReadFile(filepath.Join("testdata", name+"_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkgDir := filepath.Join(output, name)
+	if err = ioutil.WriteFile(filepath.Join(pkgDir, name+"_test.go"), test, 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command(goBin, "test", "./"+filepath.ToSlash(pkgDir)).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s\n%s", err, out)
+	}
+}
+
+func TestGenerateHelpers(t *testing.T) {
+	testGenerated(t, "helpers", nil)
+}
+
+func TestGenerateValidate(t *testing.T) {
+	testGenerated(t, "validate", nil)
+}
+
+func TestGenerateStructKeys(t *testing.T) {
+	testGenerated(t, "keys", nil)
+	testGenerated(t, "keys", func(g *Generator) { g.SetType = SetTyped })
+	testGenerated(t, "keys", func(g *Generator) { g.SetType = SetSlice })
+	testGenerated(t, "keys", func(g *Generator) { g.KeepUnknownFields = true })
+}
+
+func TestGenerateValues(t *testing.T) {
+	testGenerated(t, "values", nil)
+}
+
+func TestStructKeysNotCanonical(t *testing.T) {
+	g := New(parser.AbsPath(filepath.Join("testdata", "badkeys.thrift")), testdataPrefix+"/gen-badkeys", parser.AbsPath("testdata/gen-badkeys"))
+	err := g.Parse()
+	if err == nil || !strings.Contains(err.Error(), "Holder.by_tags: map key type Tags is not comparable: Tags has set fields") {
+		t.Fatal(err)
+	}
+}
+
+func TestGenerateUnions(t *testing.T) {
+	testGenerated(t, "unions", nil)
+	testGenerated(t, "unions", func(g *Generator) { g.KeepUnknownFields = true })
+}
+
+func TestGenerateExceptions(t *testing.T) {
+	testGenerated(t, "exceptions", nil)
+}
+
+func TestGenerateHandlers(t *testing.T) {
+	testGenerated(t, "handlers", nil)
+}
+
+func TestGenerateAnnotations(t *testing.T) {
+	testGenerated(t, "annotations", nil)
+}
+
+func TestGenerateDefinedTypedefs(t *testing.T) {
+	testGenerated(t, "typedefs", func(g *Generator) { g.DefinedTypedefs = true })
+}
+
+func TestHashKeyNotBasic(t *testing.T) {
+	output := filepath.Join("testdata", "gen-badhash")
+	defer os.RemoveAll(output)
+	g := New(parser.AbsPath(filepath.Join("testdata", "badhash.thrift")), testdataPrefix+"/gen-badhash", parser.AbsPath(output))
+	if err := g.Parse(); err != nil {
+		t.Fatal("parse:", err)
+	}
+	err := g.Generate()
+	if err == nil || !strings.Contains(err.Error(), "field shard: hash_key is not applicable to type Shard") {
+		t.Fatal(err)
+	}
+}
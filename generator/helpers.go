@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+// underlyingType resolves typedefs of typ, it returns the resolved type,
+// or typ itself if it is a struct-like or enum type.
+func (g *Generator) underlyingType(typ *parser.Type) *parser.Type {
+	for typ.Category == parser.TypeIdentifier && !g.isPtrType(typ) {
+		final, ok := typ.GetFinalType().(*parser.Type)
+		if !ok {
+			break
+		}
+		typ = final
+	}
+	return typ
+}
+
+// formatCopy returns statements which deep copy src to dst of type typ,
+// depth is used to name temporary variables of nested containers.
+func (g *Generator) formatCopy(typ *parser.Type, dst, src string, depth int) (string, error) {
+	gotype, err := g.formatType(typ)
+	if err != nil {
+		return "", err
+	}
+	typ = g.underlyingType(typ)
+	switch typ.Category {
+	case parser.TypeBasic:
+		if typ.TType() == parser.BINARY {
+			tmpl := "if %v != nil { %v = append([]byte{}, %v...) }"
+			return fmt.Sprintf(tmpl, src, dst, src), nil
+		}
+		return fmt.Sprintf("%v = %v", dst, src), nil
+	case parser.TypeIdentifier:
+		if g.isPtrType(typ) {
+			return fmt.Sprintf("%v = %v.DeepCopy()", dst, src), nil
+		}
+		// enum
+		return fmt.Sprintf("%v = %v", dst, src), nil
+	case parser.TypeContainer:
+		c, k, v := fmt.Sprintf("c%d", depth), fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		var elem string
+		switch g.containerKind(typ) {
+		case "list":
+			if g.isValueCopy(typ.ValueType) {
+				tmpl := "if %[1]v != nil {\n %[2]v := make(%[3]v, len(%[1]v))\n copy(%[2]v, %[1]v)\n %[4]v = %[2]v\n }"
+				return fmt.Sprintf(tmpl, src, c, gotype, dst), nil
+			}
+			if elem, err = g.formatCopy(typ.ValueType, c+"["+k+"]", v, depth+1); err != nil {
+				return "", err
+			}
+			tmpl := "if %[1]v != nil {\n %[2]v := make(%[3]v, len(%[1]v))\n for %[4]v, %[5]v := range %[1]v {\n %[6]v\n }\n %[7]v = %[2]v\n }"
+			return fmt.Sprintf(tmpl, src, c, gotype, k, v, elem, dst), nil
+		case "set":
+			tmpl := "if %[1]v != nil {\n %[2]v := make(%[3]v, len(%[1]v))\n for %[4]v, %[5]v := range %[1]v {\n %[2]v[%[4]v] = %[5]v\n }\n %[6]v = %[2]v\n }"
+			return fmt.Sprintf(tmpl, src, c, gotype, k, v, dst), nil
+		case "map":
+			if elem, err = g.formatCopy(typ.ValueType, c+"["+k+"]", v, depth+1); err != nil {
+				return "", err
+			}
+			if g.isValueCopy(typ.ValueType) {
+				elem = c + "[" + k + "] = " + v
+			} else if !g.isPtrType(typ.ValueType) {
+				// keep nil values of binary and containers
+				elem = c + "[" + k + "] = " + v + "\n" + elem
+			}
+			tmpl := "if %[1]v != nil {\n %[2]v := make(%[3]v, len(%[1]v))\n for %[4]v, %[5]v := range %[1]v {\n %[6]v\n }\n %[7]v = %[2]v\n }"
+			return fmt.Sprintf(tmpl, src, c, gotype, k, v, elem, dst), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type: %v", typ.Name)
+}
+
+// containerKind returns the Go representation of container typ, which is
+// "list" for sets represented as slices, or the name of typ otherwise.
+// Sets represented as slices are copied and compared like lists, which
+// preserves the order of elements.
+func (g *Generator) containerKind(typ *parser.Type) string {
+	if typ.Name == "set" && g.setKind(typ) == SetSlice {
+		return "list"
+	}
+	return typ.Name
+}
+
+// isValueCopy tells whether a value of typ is copied by assignment.
+func (g *Generator) isValueCopy(typ *parser.Type) bool {
+	typ = g.underlyingType(typ)
+	switch typ.Category {
+	case parser.TypeBasic:
+		return typ.TType() != parser.BINARY
+	case parser.TypeIdentifier:
+		return !g.isPtrType(typ)
+	}
+	return false
+}
+
+// formatEquals returns statements which return false if a and b of type
+// typ are not equal.
+func (g *Generator) formatEquals(typ *parser.Type, a, b string, depth int) (string, error) {
+	typ = g.underlyingType(typ)
+	switch typ.Category {
+	case parser.TypeBasic:
+		if typ.TType() == parser.BINARY {
+			return fmt.Sprintf("if !bytes.Equal(%v, %v) { return false }", a, b), nil
+		}
+		return fmt.Sprintf("if %v != %v { return false }", a, b), nil
+	case parser.TypeIdentifier:
+		if g.isPtrType(typ) {
+			return fmt.Sprintf("if !%v.Equals(%v) { return false }", a, b), nil
+		}
+		// enum
+		return fmt.Sprintf("if %v != %v { return false }", a, b), nil
+	case parser.TypeContainer:
+		k, v, w := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth), fmt.Sprintf("w%d", depth)
+		// nil equals empty, empty containers are not written
+		head := fmt.Sprintf("if len(%v) != len(%v) { return false }\n", a, b)
+		switch g.containerKind(typ) {
+		case "list":
+			elem, err := g.formatEquals(typ.ValueType, v, w, depth+1)
+			if err != nil {
+				return "", err
+			}
+			tmpl := "for %[3]v, %[4]v := range %[1]v {\n %[5]v := %[2]v[%[3]v]\n %[6]v\n }"
+			return head + fmt.Sprintf(tmpl, a, b, k, v, w, elem), nil
+		case "set":
+			tmpl := "for %[3]v := range %[1]v {\n if _, ok := %[2]v[%[3]v]; !ok { return false }\n }"
+			return head + fmt.Sprintf(tmpl, a, b, k), nil
+		case "map":
+			elem, err := g.formatEquals(typ.ValueType, v, w, depth+1)
+			if err != nil {
+				return "", err
+			}
+			tmpl := "for %[3]v, %[4]v := range %[1]v {\n %[5]v, ok := %[2]v[%[3]v]\n if !ok { return false }\n %[6]v\n }"
+			return head + fmt.Sprintf(tmpl, a, b, k, v, w, elem), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type: %v", typ.Name)
+}
+
+// copyField returns statements which deep copy the field from p to q.
+func (g *Generator) copyField(field *parser.Field) (string, error) {
+	name := g.fieldName(field)
+	src, dst := "p."+name, "q."+name
+	if !g.isPtrField(field) || g.isPtrType(field.Type) {
+		return g.formatCopy(field.Type, dst, src, 0)
+	}
+	// pointer to basic type or enum
+	var stmt string
+	if !g.isValueCopy(field.Type) {
+		var err error
+		if stmt, err = g.formatCopy(field.Type, "v", "v", 0); err != nil {
+			return "", err
+		}
+	}
+	tmpl := "if %[1]v != nil {\n v := *%[1]v\n %[2]v\n %[3]v = &v\n }"
+	return fmt.Sprintf(tmpl, src, stmt, dst), nil
+}
+
+// equalsField returns statements which return false if the field of p
+// and other are not equal.
+func (g *Generator) equalsField(field *parser.Field) (string, error) {
+	name := g.fieldName(field)
+	a, b := "p."+name, "other."+name
+	if !g.isPtrField(field) || g.isPtrType(field.Type) {
+		return g.formatEquals(field.Type, a, b, 0)
+	}
+	// pointer to basic type or enum
+	stmt, err := g.formatEquals(field.Type, "*"+a, "*"+b, 0)
+	if err != nil {
+		return "", err
+	}
+	tmpl := "if (%[1]v == nil) != (%[2]v == nil) { return false }\n if %[1]v != nil {\n %[3]v\n }"
+	return fmt.Sprintf(tmpl, a, b, stmt), nil
+}
+
+// isPtrValueField tells whether the field is a pointer to basic type or
+// enum, which should be dereferenced when printing.
+func (g *Generator) isPtrValueField(field *parser.Field) bool {
+	return g.isPtrField(field) && !g.isPtrType(field.Type)
+}
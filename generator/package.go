@@ -14,6 +14,8 @@ import (
 type Package struct {
 	*parser.Document
 	G *Generator
+
+	customImports map[string]customImport // key: import path
 }
 
 func (p *Package) Name() string {
@@ -136,7 +138,7 @@ func (p *Package) Generate() error {
 		}
 	}
 	for _, x := range p.Unions {
-		if err = p.G.tmpl("write_union.tmpl").Execute(&buf, x); err != nil {
+		if err = p.G.tmpl("write_union.tmpl").Execute(&buf, (*parser.Struct)(x)); err != nil {
 			log.Println("encoder:", err)
 			return err
 		}
@@ -160,6 +162,76 @@ func (p *Package) Generate() error {
 		return err
 	}
 
+	// value helpers
+	helpersFile, err := filepath.Abs(filepath.Join(outDir, "helpers.go"))
+	if err != nil {
+		return err
+	}
+	buf.Reset()
+	if err = p.G.tmpl("header.tmpl").Execute(&buf, p); err != nil {
+		log.Println("helpers:", err)
+		return err
+	}
+	for _, x := range p.Structs {
+		if err = p.G.tmpl("helpers.tmpl").Execute(&buf, x); err != nil {
+			log.Println("helpers:", err)
+			return err
+		}
+	}
+	for _, x := range p.Unions {
+		if err = p.G.tmpl("helpers.tmpl").Execute(&buf, (*parser.Struct)(x)); err != nil {
+			log.Println("helpers:", err)
+			return err
+		}
+	}
+	if code, err = p.G.formatCode(buf.Bytes()); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(helpersFile, code, 0644); err != nil {
+		return err
+	}
+
+	// validators
+	validatorFile, err := filepath.Abs(filepath.Join(outDir, "validator.go"))
+	if err != nil {
+		return err
+	}
+	buf.Reset()
+	if err = p.G.tmpl("header.tmpl").Execute(&buf, p); err != nil {
+		log.Println("validator:", err)
+		return err
+	}
+	for _, x := range p.Structs {
+		if err = p.G.tmpl("validate.tmpl").Execute(&buf, x); err != nil {
+			log.Println("validator:", err)
+			return err
+		}
+	}
+	for _, x := range p.Unions {
+		if err = p.G.tmpl("validate.tmpl").Execute(&buf, (*parser.Struct)(x)); err != nil {
+			log.Println("validator:", err)
+			return err
+		}
+	}
+	for _, svc := range p.Services {
+		argStructs, err := p.G.parseArguments(svc)
+		if err != nil {
+			return err
+		}
+		for _, x := range argStructs {
+			if err = p.G.tmpl("validate.tmpl").Execute(&buf, x); err != nil {
+				log.Println("validator:", err)
+				return err
+			}
+		}
+	}
+	if code, err = p.G.formatCode(buf.Bytes()); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(validatorFile, code, 0644); err != nil {
+		return err
+	}
+
 	if len(p.Services) > 0 {
 		// go-kit server
 		kitserverFile, err := filepath.Abs(filepath.Join(outDir, "kitserver.go"))
@@ -238,18 +310,6 @@ func (p *Package) gencode() ([]byte, error) {
 	// Structs, Exceptions
 	p.Structs = append(p.Structs, p.Exceptions...)
 
-	// Unions
-	// Field of union should all be optional.
-	for _, un := range p.Unions {
-		for _, f := range un.Fields {
-			if f.Requiredness != parser.ReqDefault {
-				log.Printf("union %v field %v: union members must be optional, ignoring specified requiredness\n", un.Name, f.Name)
-				f.Requiredness = parser.ReqDefault
-			}
-			f.Optional = true
-		}
-	}
-
 	if err = p.G.tmpl("structs.tmpl").Execute(&buf, p); err != nil {
 		return nil, err
 	}
@@ -262,6 +322,14 @@ func (p *Package) gencode() ([]byte, error) {
 		return nil, err
 	}
 
+	if err = p.G.tmpl("sets.tmpl").Execute(&buf, p); err != nil {
+		return nil, err
+	}
+
+	if err = p.G.tmpl("keys.tmpl").Execute(&buf, p); err != nil {
+		return nil, err
+	}
+
 	if err = p.G.tmpl("service.tmpl").Execute(&buf, p); err != nil {
 		return nil, err
 	}
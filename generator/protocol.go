@@ -15,6 +15,15 @@ func (g *Generator) parseRefType(typ *parser.Type) (refName, typeName string) {
 	return parts[0], ToCamelCase(parts[1])
 }
 
+// formatNew returns the constructor function of the struct-like type.
+func (g *Generator) formatNew(typ *parser.Type) string {
+	refName, typeName := g.parseRefType(typ)
+	if refName == "" {
+		return "New" + typeName
+	}
+	return refName + ".New" + typeName
+}
+
 func (g *Generator) formatRead(typ *parser.Type, variable string) (string, error) {
 	//fieldName := ToCamelCase(field.Name)
 	//typeName := ToCamelCase(typ.Name)
@@ -70,17 +79,30 @@ func (g *Generator) formatRead(typ *parser.Type, variable string) (string, error
 		return "", fmt.Errorf("unsupported type: %v", typ.Name)
 	case parser.TypeIdentifier:
 		if g.isPtrType(typ) {
-			refName, typeName := g.parseRefType(typ)
-			if refName == "" {
-				tmpl := "%v = New%v()\n  if err = %v.Read(r); err != nil { return err }"
-				return fmt.Sprintf(tmpl, variable, typeName, variable), nil
-			}
-			tmpl := "%v = %v.New%v()\n  if err = %v.Read(r); err != nil { return err }"
-			return fmt.Sprintf(tmpl, variable, refName, typeName, variable), nil
+			tmpl := "%v = %v()\n  if err = %v.Read(r); err != nil { return err }"
+			return fmt.Sprintf(tmpl, variable, g.formatNew(typ), variable), nil
 		}
 		if finalType := typ.GetFinalType(); finalType != nil {
-			if typ, ok := finalType.(*parser.Type); ok {
-				return g.formatRead(typ, variable)
+			if final, ok := finalType.(*parser.Type); ok {
+				// typed set is a named type, which needs conversion to
+				// the typedef like basic types
+				isTypedSet := final.Name == "set" && g.setKind(final) == SetTyped
+				if final.Category != parser.TypeBasic && !isTypedSet {
+					return g.formatRead(final, variable)
+				}
+				// typedef of basic type, which may be a defined type or
+				// a custom type specified by annotation "go.type"
+				tt, _ := g.formatType(typ)
+				ft, _ := g.formatType(final)
+				code, err := g.formatRead(final, "x")
+				if err != nil {
+					return "", err
+				}
+				if from, ok := parser.GetAnnotation(typ.Annotations, "go.from"); ok {
+					tt = from
+				}
+				tmpl := "{\n var x %v\n %v\n %v = %v(x)\n }"
+				return fmt.Sprintf(tmpl, ft, code, variable, tt), nil
 			}
 			if _, ok := finalType.(*parser.Enum); ok {
 				tt, _ := g.formatType(typ)
@@ -95,6 +117,42 @@ func (g *Generator) formatRead(typ *parser.Type, variable string) (string, error
 	}
 }
 
+// formatReadKey is like formatRead, but reads struct types by value or
+// as encoded keys, which is used to read map keys and set elements.
+func (g *Generator) formatReadKey(typ *parser.Type, variable string) (string, error) {
+	if !g.isPtrType(typ) {
+		return g.formatRead(typ, variable)
+	}
+	tt, err := g.formatType(typ)
+	if err != nil {
+		return "", err
+	}
+	code, err := g.formatRead(typ, "x")
+	if err != nil {
+		return "", err
+	}
+	value := "*x"
+	if g.isEncodedKey(typ) {
+		value = "x.Key()"
+	}
+	tmpl := "{\n var x *%v\n %v\n %v = %v\n }"
+	return fmt.Sprintf(tmpl, tt, code, variable, value), nil
+}
+
+// formatWriteKey is like formatWrite, but writes encoded keys as their
+// structs, which is used to write map keys and set elements.
+func (g *Generator) formatWriteKey(typ *parser.Type, variable string) (string, error) {
+	if !g.isEncodedKey(typ) {
+		return g.formatWrite(typ, variable)
+	}
+	code, err := g.formatWrite(typ, "x")
+	if err != nil {
+		return "", err
+	}
+	tmpl := "{\n x, err := %v.Struct()\n if err != nil { return err }\n %v\n }"
+	return fmt.Sprintf(tmpl, variable, code), nil
+}
+
 func (g *Generator) formatWrite(typ *parser.Type, variable string) (string, error) {
 	//fieldName := ToCamelCase(field.Name)
 	typeName := ToCamelCase(typ.Name)
@@ -155,8 +213,17 @@ func (g *Generator) formatWrite(typ *parser.Type, variable string) (string, erro
 			return fmt.Sprintf(tmpl, variable), nil
 		}
 		if finalType := typ.GetFinalType(); finalType != nil {
-			if typ, ok := finalType.(*parser.Type); ok {
-				return g.formatWrite(typ, variable)
+			if final, ok := finalType.(*parser.Type); ok {
+				if final.Category != parser.TypeBasic {
+					return g.formatWrite(final, variable)
+				}
+				// typedef of basic type, which may be a defined type or
+				// a custom type specified by annotation "go.type"
+				ft, _ := g.formatType(final)
+				if to, ok := parser.GetAnnotation(typ.Annotations, "go.to"); ok {
+					ft = to
+				}
+				return g.formatWrite(final, fmt.Sprintf("%v(%v)", ft, variable))
 			}
 			if _, ok := finalType.(*parser.Enum); ok {
 				tmpl := "if err = w.WriteI32(int32(%v)); err != nil { return err }"
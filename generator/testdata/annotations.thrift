@@ -0,0 +1,16 @@
+namespace go annotations
+
+// Custom has the same wire format as Plain.
+struct Plain {
+    1: i64 timeout;
+    2: binary addr;
+    3: string user_id;
+    4: optional i64 retry;
+}
+
+struct Custom {
+    1: i64 timeout (go.type = "time.Duration");
+    2: binary addr (go.type = "net.IP");
+    3: string user_id (go.name = "UserID", go.tag = 'db:"uid"');
+    4: optional i64 retry (go.type = "time.Duration", go.tag = 'json:"retry_after,omitempty"');
+}
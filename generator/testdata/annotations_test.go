@@ -0,0 +1,80 @@
+package annotations
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func TestCustomTypesWire(t *testing.T) {
+	retry := 3 * time.Second
+	x := &Custom{
+		Timeout: time.Second,
+		Addr:    net.IPv4(10, 0, 0, 1),
+		UserID:  "u1",
+		Retry:   &retry,
+	}
+	retryInt := int64(retry)
+	plain := &Plain{
+		Timeout: int64(time.Second),
+		Addr:    []byte(net.IPv4(10, 0, 0, 1)),
+		UserId:  "u1",
+		Retry:   &retryInt,
+	}
+
+	// the custom types are written as the wire types
+	data, err := thrift.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := thrift.Marshal(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("wire bytes differ:\n%x\n%x", data, want)
+	}
+
+	y := &Custom{}
+	if err = thrift.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equals(y) || !y.Addr.Equal(x.Addr) || *y.Retry != retry {
+		t.Fatalf("%v != %v", y, x)
+	}
+	if z := x.DeepCopy(); !z.Equals(x) || z.Retry == x.Retry {
+		t.Fatalf("unexpected copy: %v", z)
+	}
+}
+
+func TestCustomNamesAndTags(t *testing.T) {
+	typ := reflect.TypeOf(Custom{})
+	f, ok := typ.FieldByName("UserID")
+	if !ok {
+		t.Fatal("field UserID not found")
+	}
+	if f.Tag.Get("db") != "uid" || f.Tag.Get("json") != "user_id" || f.Tag.Get("thrift") != "user_id,3" {
+		t.Fatalf("unexpected tag: %v", f.Tag)
+	}
+	// the json tag replaces the generated one
+	f, _ = typ.FieldByName("Retry")
+	if f.Tag.Get("json") != "retry_after,omitempty" {
+		t.Fatalf("unexpected tag: %v", f.Tag)
+	}
+
+	// net.IP is encoded as text by encoding/json
+	retry := time.Duration(2)
+	data, err := json.Marshal(&Custom{Addr: net.IPv4(10, 0, 0, 1), UserID: "u", Retry: &retry})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"timeout":0,"addr":"10.0.0.1","user_id":"u","retry_after":2}`
+	if string(data) != want {
+		t.Fatalf("json = %s, want %s", data, want)
+	}
+}
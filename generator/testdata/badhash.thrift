@@ -0,0 +1,9 @@
+namespace go badhash
+
+struct Shard {
+    1: i32 id;
+}
+
+struct Request {
+    1: Shard shard (hash_key);
+}
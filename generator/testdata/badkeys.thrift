@@ -0,0 +1,10 @@
+namespace go badkeys
+
+// the encoding of sets is not canonical
+struct Tags {
+    1: set<string> tags
+}
+
+struct Holder {
+    1: map<Tags, i32> by_tags
+}
@@ -0,0 +1,29 @@
+namespace go exceptions
+
+exception NotFound {
+    1: string message;
+    2: string key;
+}
+
+exception Invalid {
+    1: i32 code;
+    2: string reason;
+} (message = "reason")
+
+struct GetRequest {
+    1: string key;
+}
+
+struct GetResponse {
+    1: string value;
+}
+
+struct PutRequest {
+    1: string key;
+    2: string value;
+}
+
+service Store {
+    GetResponse Get(1: GetRequest req) throws (1: NotFound not_found, 2: Invalid invalid);
+    void Put(1: PutRequest req) throws (1: Invalid invalid);
+}
@@ -0,0 +1,103 @@
+package exceptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func TestErrorMessage(t *testing.T) {
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{&NotFound{Message: "no such key", Key: "k"}, "no such key"},
+		{&Invalid{Code: 1, Reason: "bad value"}, "bad value"},
+		// the string form is used without message
+		{&Invalid{Code: 1}, (&Invalid{Code: 1}).String()},
+	}
+	for _, c := range cases {
+		if got := c.err.Error(); got != c.want {
+			t.Fatalf("error = %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	err := fmt.Errorf("get: %w", &NotFound{Key: "k"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatal("wrapped NotFound does not match ErrNotFound")
+	}
+	if errors.Is(err, ErrInvalid) {
+		t.Fatal("NotFound matches ErrInvalid")
+	}
+	if !errors.Is(&Invalid{Code: 2}, ErrInvalid) {
+		t.Fatal("Invalid does not match ErrInvalid")
+	}
+}
+
+func startServer(t *testing.T, h StoreHandler) (*StoreClient, func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewServer(NewStoreProcessor(h))
+	go server.ServeListener(ln)
+	cli := thrift.NewClient(thrift.StdDialer, ln.Addr().String())
+	return NewStoreClient(cli), func() {
+		cli.Close()
+		server.Stop()
+	}
+}
+
+func TestProcessWrappedExceptions(t *testing.T) {
+	h := &StoreHandlerFuncs{
+		GetFunc: func(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+			switch key := req.Key; key {
+			case "missing":
+				return nil, fmt.Errorf("get %v: %w", key, &NotFound{Message: "not found", Key: key})
+			case "bad":
+				return nil, &Invalid{Code: 3, Reason: "bad key"}
+			case "fail":
+				return nil, errors.New("internal error")
+			}
+			return &GetResponse{Value: "v"}, nil
+		},
+		PutFunc: func(ctx context.Context, req *PutRequest) error {
+			return fmt.Errorf("put %v: %w", req.Key, &Invalid{Code: 4, Reason: "read only"})
+		},
+	}
+	cli, stop := startServer(t, h)
+	defer stop()
+	ctx := context.Background()
+
+	if rsp, err := cli.Get(ctx, &GetRequest{Key: "k"}); err != nil || rsp.Value != "v" {
+		t.Fatalf("get = %v, %v", rsp, err)
+	}
+
+	// wrapped exceptions are replied as the declared exceptions
+	_, err := cli.Get(ctx, &GetRequest{Key: "missing"})
+	var nf *NotFound
+	if !errors.As(err, &nf) || nf.Key != "missing" || nf.Message != "not found" {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	_, err = cli.Get(ctx, &GetRequest{Key: "bad"})
+	var inv *Invalid
+	if !errors.As(err, &inv) || inv.Code != 3 {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	err = cli.Put(ctx, &PutRequest{Key: "k", Value: "v"})
+	if !errors.As(err, &inv) || inv.Code != 4 || inv.Reason != "read only" {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+
+	// other errors are replied as application exceptions
+	_, err = cli.Get(ctx, &GetRequest{Key: "fail"})
+	if _, ok := err.(*thrift.ApplicationException); !ok || errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalid) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+}
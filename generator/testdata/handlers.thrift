@@ -0,0 +1,14 @@
+namespace go handlers
+
+struct HelloRequest {
+    1: string name;
+}
+
+struct HelloResponse {
+    1: string greeting;
+}
+
+service Greeter {
+    HelloResponse Hello(1: HelloRequest req);
+    void Bye(1: HelloRequest req);
+}
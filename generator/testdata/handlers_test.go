@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func isUnknownMethod(err error) bool {
+	ex, ok := err.(*thrift.ApplicationException)
+	return ok && ex.TypeID() == thrift.UNKNOWN_METHOD
+}
+
+func TestUnimplementedHandler(t *testing.T) {
+	var h GreeterHandler = UnimplementedGreeterHandler{}
+	ctx := context.Background()
+	if rsp, err := h.Hello(ctx, &HelloRequest{}); rsp != nil || !isUnknownMethod(err) {
+		t.Fatalf("hello = %v, %v", rsp, err)
+	}
+	if err := h.Bye(ctx, &HelloRequest{}); !isUnknownMethod(err) {
+		t.Fatalf("bye = %v", err)
+	}
+}
+
+func TestHandlerFuncs(t *testing.T) {
+	var h GreeterHandler = GreeterHandlerFuncs{
+		HelloFunc: func(ctx context.Context, req *HelloRequest) (*HelloResponse, error) {
+			return &HelloResponse{Greeting: "hello " + req.Name}, nil
+		},
+	}
+	ctx := context.Background()
+	if rsp, err := h.Hello(ctx, &HelloRequest{Name: "a"}); err != nil || rsp.Greeting != "hello a" {
+		t.Fatalf("hello = %v, %v", rsp, err)
+	}
+	// methods whose function is nil are not implemented
+	if err := h.Bye(ctx, &HelloRequest{}); !isUnknownMethod(err) {
+		t.Fatalf("bye = %v", err)
+	}
+}
+
+// partialHandler implements Hello only, it gets Bye by embedding.
+type partialHandler struct {
+	UnimplementedGreeterHandler
+}
+
+func (partialHandler) Hello(ctx context.Context, req *HelloRequest) (*HelloResponse, error) {
+	return &HelloResponse{Greeting: "hi"}, nil
+}
+
+func TestProcessUnimplemented(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewServer(NewGreeterProcessor(partialHandler{}))
+	go server.ServeListener(ln)
+	defer server.Stop()
+	invoker := thrift.NewClient(thrift.StdDialer, ln.Addr().String())
+	defer invoker.Close()
+	cli := NewGreeterClient(invoker)
+
+	ctx := context.Background()
+	if rsp, err := cli.Hello(ctx, &HelloRequest{}); err != nil || rsp.Greeting != "hi" {
+		t.Fatalf("hello = %v, %v", rsp, err)
+	}
+	// the exception is replied to the client
+	if err := cli.Bye(ctx, &HelloRequest{}); !isUnknownMethod(err) {
+		t.Fatalf("bye = %#v", err)
+	}
+}
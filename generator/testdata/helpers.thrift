@@ -0,0 +1,44 @@
+namespace go helpers
+
+typedef list<Point> Points
+typedef map<string, i32> Counts
+typedef binary Blob
+
+enum Color {
+    RED = 1;
+    GREEN = 2;
+}
+
+struct Point {
+    1: i32 x;
+    2: i32 y;
+}
+
+struct Everything {
+    1: required i64 id;
+    2: optional string name;
+    3: list<string> tags;
+    4: set<i32> ids;
+    5: map<string, Point> named;
+    6: Points points;
+    7: Counts counts;
+    8: binary data;
+    9: optional Blob blob;
+    10: optional Color color;
+    11: Point origin;
+    12: optional list<i32> extra;
+    13: map<i32, list<string>> nested;
+    14: double ratio;
+}
+
+union Value {
+    1: i64 int_val;
+    2: string str_val;
+    3: Point point_val;
+    4: list<string> list_val;
+}
+
+exception NotFound {
+    1: string message;
+    2: i32 code;
+}
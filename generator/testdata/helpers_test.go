@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func roundTrip(t *testing.T, x *Everything) *Everything {
+	data, err := thrift.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := NewEverything()
+	if err = thrift.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	return y
+}
+
+func TestEqualsRoundTrip(t *testing.T) {
+	// the nil containers are read back as empty ones and vice versa
+	x := NewEverything()
+	x.Origin = &Point{}
+	if y := roundTrip(t, x); !x.Equals(y) || !y.Equals(x) {
+		t.Fatalf("%v != %v", x, y)
+	}
+
+	name, blob, color := "n", Blob("b"), Color_GREEN
+	x = &Everything{
+		Id: 1, Name: &name, Tags: []string{"a"}, Ids: map[int32]bool{1: true},
+		Named:  map[string]*Point{"p": {X: 1, Y: 2}},
+		Points: Points{{X: 3}}, Counts: Counts{"c": 1},
+		Data: []byte("abc"), Blob: &blob, Color: &color, Origin: &Point{X: 4},
+		Extra: []int32{}, Nested: map[int32][]string{1: {"z"}, 2: {}},
+		Ratio: 0.5,
+	}
+	y := roundTrip(t, x)
+	if !x.Equals(y) || !y.Equals(x) {
+		t.Fatalf("%v != %v", x, y)
+	}
+
+	// an empty optional container is not written
+	if y.Extra != nil {
+		t.Fatalf("%#v", y.Extra)
+	}
+	y.Named["p"].Y = 3
+	if x.Equals(y) || y.Equals(x) {
+		t.Fatal("different nested values are equal")
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	name, blob := "n", Blob("b")
+	x := &Everything{
+		Name: &name, Tags: []string{"a"}, Named: map[string]*Point{"p": {X: 1}, "nil": nil},
+		Points: Points{{X: 3}}, Data: []byte("abc"), Blob: &blob,
+		Nested: map[int32][]string{1: {"z"}, 2: nil},
+	}
+	y := x.DeepCopy()
+	if !x.Equals(y) {
+		t.Fatalf("%v != %v", x, y)
+	}
+	if _, ok := y.Named["nil"]; !ok {
+		t.Fatal("nil map value is lost")
+	}
+	*y.Name = "m"
+	y.Tags[0] = "b"
+	y.Named["p"].X = 2
+	y.Points[0].X = 4
+	y.Data[0] = 'x'
+	(*y.Blob)[0] = 'x'
+	y.Nested[1][0] = "y"
+	if name != "n" || x.Tags[0] != "a" || x.Named["p"].X != 1 || x.Points[0].X != 3 ||
+		string(x.Data) != "abc" || string(blob) != "b" || x.Nested[1][0] != "z" {
+		t.Fatalf("the copy shares memory: %v", x)
+	}
+
+	var nilx *Everything
+	if nilx.DeepCopy() != nil || !nilx.Equals(nil) || nilx.Equals(x) || x.Equals(nil) {
+		t.Fatal("nil is not handled")
+	}
+
+	v := &Value{PointVal: &Point{X: 1}}
+	if w := v.DeepCopy(); !w.Equals(v) || w.PointVal == v.PointVal {
+		t.Fatalf("%v", w)
+	}
+}
+
+func TestString(t *testing.T) {
+	x := &Everything{Id: 2, Named: map[string]*Point{"p": {X: 1}}}
+	s := x.String()
+	if !strings.HasPrefix(s, "Everything({Id:2 Name:<nil> Tags:[]") ||
+		!strings.Contains(s, " Named:map[p:Point({X:1 Y:0})]") {
+		t.Fatal(s)
+	}
+	v := &Value{StrVal: new(string)}
+	if s = v.String(); s != "Value({IntVal:<nil> StrVal: PointVal:<nil> ListVal:[]})" {
+		t.Fatal(s)
+	}
+	if s = (*Point)(nil).String(); s != "<nil>" {
+		t.Fatal(s)
+	}
+
+	var err error = &NotFound{Message: "gone", Code: 404}
+	var nf *NotFound
+	if !errors.As(err, &nf) || nf.Code != 404 {
+		t.Fatal(err)
+	}
+	if !nf.Equals(nf.DeepCopy()) {
+		t.Fatal(nf)
+	}
+}
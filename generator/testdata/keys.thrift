@@ -0,0 +1,46 @@
+namespace go keys
+
+// comparable, used by value
+struct Flat {
+    1: i32 a
+    2: string b
+}
+
+// not comparable, used by encoded keys
+struct Opt {
+    1: i32 a
+    2: optional string b
+}
+
+// not comparable, used by encoded keys
+struct Outer {
+    1: Flat flat
+}
+
+struct Keys {
+    1: map<Flat, string> flat_map
+    2: set<Flat> flat_set
+    3: map<Opt, string> opt_map
+    4: set<Opt> opt_set
+    5: map<Outer, list<Opt>> outer_map
+    6: set<Outer> outer_set
+}
+
+const Keys KEYS = {
+    "flat_map": {{"a": 1, "b": "x"}: "1", {"a": 2}: "2"},
+    "flat_set": [{"a": 1}, {"a": 2}],
+    "opt_map": {{"a": 1, "b": "x"}: "1", {"a": 1}: "2"},
+    "opt_set": [{"a": 1, "b": "x"}, {"a": 1}],
+    "outer_map": {{"flat": {"a": 1}}: [{"a": 1}], {"flat": {"a": 2}}: []},
+    "outer_set": [{"flat": {"a": 1}}, {"flat": {"a": 2}}],
+}
+
+// same as KEYS, sets are in the same order as sets may be slices
+const Keys SAME = {
+    "flat_map": {{"a": 2}: "2", {"a": 1, "b": "x"}: "1"},
+    "flat_set": [{"a": 1}, {"a": 2}],
+    "opt_map": {{"a": 1}: "2", {"a": 1, "b": "x"}: "1"},
+    "opt_set": [{"a": 1, "b": "x"}, {"a": 1}],
+    "outer_map": {{"flat": {"a": 2}}: [], {"flat": {"a": 1}}: [{"a": 1}]},
+    "outer_set": [{"flat": {"a": 1}}, {"flat": {"a": 2}}],
+}
@@ -0,0 +1,49 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func TestStructKeys(t *testing.T) {
+	if len(KEYS.FlatMap) != 2 || len(KEYS.OptMap) != 2 || len(KEYS.OptSet) != 2 || len(KEYS.OuterSet) != 2 {
+		t.Fatal(KEYS)
+	}
+	// the keys are compared by fields
+	if !KEYS.Equals(SAME) || !SAME.Equals(KEYS) {
+		t.Fatalf("%v != %v", KEYS, SAME)
+	}
+	b := "x"
+	if KEYS.OptMap[(&Opt{A: 1}).Key()] != "2" || KEYS.OptMap[(&Opt{A: 1, B: &b}).Key()] != "1" {
+		t.Fatal(KEYS.OptMap)
+	}
+	if len(KEYS.OuterMap[(&Outer{Flat: &Flat{A: 1}}).Key()]) != 1 {
+		t.Fatal(KEYS.OuterMap)
+	}
+	for k := range KEYS.OptMap {
+		if p, err := k.Struct(); err != nil || p.A != 1 || k.String() != p.String() {
+			t.Fatal(p, err)
+		}
+	}
+
+	data, err := thrift.Marshal(KEYS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := NewKeys()
+	if err = thrift.Unmarshal(data, x); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equals(KEYS) || !KEYS.Equals(x) {
+		t.Fatalf("%v != %v", x, KEYS)
+	}
+	if y := x.DeepCopy(); !y.Equals(x) {
+		t.Fatalf("%v != %v", y, x)
+	}
+
+	x.OuterMap = NewKeys().OuterMap
+	if x.Equals(KEYS) || KEYS.Equals(x) {
+		t.Fatal("different maps are equal")
+	}
+}
@@ -0,0 +1,34 @@
+namespace go typedefs
+
+typedef i64 UserID
+typedef string Email (go.defined = "false")
+typedef binary Blob
+typedef list<UserID> UserIDs
+typedef map<UserID, Email> Emails
+
+const UserID ROOT = 1
+const UserIDs ADMINS = [1, 2]
+const map<UserID, string> NAMES = {1: "root"}
+
+struct User {
+    1: UserID id;
+    2: optional UserID manager;
+    3: UserIDs friends;
+    4: set<UserID> followers;
+    5: Emails emails;
+    6: list<list<UserID>> groups;
+    7: Blob avatar;
+    8: UserID level = 3;
+}
+
+// Plain has the same wire format as User.
+struct Plain {
+    1: i64 id;
+    2: optional i64 manager;
+    3: list<i64> friends;
+    4: set<i64> followers;
+    5: map<i64, string> emails;
+    6: list<list<i64>> groups;
+    7: binary avatar;
+    8: i64 level = 3;
+}
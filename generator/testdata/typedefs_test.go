@@ -0,0 +1,95 @@
+package typedefs
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func TestDefinedTypes(t *testing.T) {
+	cases := []struct {
+		v    interface{}
+		name string
+	}{
+		{ROOT, "UserID"},
+		{ADMINS, "UserIDs"},
+		{ADMINS[0], "UserID"},
+		{Blob{}, "Blob"},
+		{Emails{}, "Emails"},
+		{User_Level_DEFAULT, "UserID"},
+	}
+	for _, c := range cases {
+		if name := reflect.TypeOf(c.v).Name(); name != c.name {
+			t.Fatalf("type of %v = %v, want %v", c.v, name, c.name)
+		}
+	}
+	for k := range NAMES {
+		if reflect.TypeOf(k).Name() != "UserID" {
+			t.Fatalf("key type of NAMES is %T", k)
+		}
+	}
+	if ROOT != 1 || len(ADMINS) != 2 || ADMINS[1] != 2 || NAMES[ROOT] != "root" {
+		t.Fatalf("unexpected constants: %v %v %v", ROOT, ADMINS, NAMES)
+	}
+
+	// go.defined overrides the option, Email is an alias of string
+	var email Email = "a@b"
+	var s string = email
+	_ = s
+}
+
+func TestDefinedTypesWire(t *testing.T) {
+	manager := UserID(7)
+	x := &User{
+		Id:        1,
+		Manager:   &manager,
+		Friends:   UserIDs{2, 3},
+		Followers: map[UserID]bool{4: true},
+		Emails:    Emails{5: "e@x"},
+		Groups:    [][]UserID{{6}, {}},
+		Avatar:    Blob("png"),
+		Level:     8,
+	}
+	plainManager := int64(7)
+	plain := &Plain{
+		Id:        1,
+		Manager:   &plainManager,
+		Friends:   []int64{2, 3},
+		Followers: map[int64]bool{4: true},
+		Emails:    map[int64]string{5: "e@x"},
+		Groups:    [][]int64{{6}, {}},
+		Avatar:    []byte("png"),
+		Level:     8,
+	}
+
+	// the defined types are written as their underlying types
+	data, err := thrift.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := thrift.Marshal(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("wire bytes differ:\n%x\n%x", data, want)
+	}
+
+	y := NewUser()
+	if err = thrift.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equals(y) || *y.Manager != manager || y.Emails[5] != "e@x" || string(y.Avatar) != "png" {
+		t.Fatalf("%v != %v", y, x)
+	}
+	if z := x.DeepCopy(); !z.Equals(x) {
+		t.Fatalf("%v != %v", z, x)
+	}
+
+	// the default is a defined type too
+	if u := NewUser(); u.Level != 3 || u.GetLevel() != User_Level_DEFAULT {
+		t.Fatalf("unexpected default: %v", u.Level)
+	}
+}
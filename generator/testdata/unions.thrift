@@ -0,0 +1,20 @@
+namespace go unions
+
+struct Point {
+    1: i32 x;
+    2: i32 y;
+}
+
+union Value {
+    1: i64 int_val;
+    2: string str_val;
+    3: Point point_val;
+    4: list<string> list_val;
+}
+
+// Pair has the fields of Value, which may be read as a Value with more
+// than one field set.
+struct Pair {
+    1: optional i64 int_val;
+    2: optional string str_val;
+}
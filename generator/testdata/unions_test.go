@@ -0,0 +1,97 @@
+package unions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func TestWhich(t *testing.T) {
+	cases := []struct {
+		v     *Value
+		which Value_Which
+		name  string
+	}{
+		{&Value{}, Value_Which_NONE, "<UNSET>"},
+		{NewValueWithIntVal(0), Value_Which_IntVal, "int_val"},
+		{NewValueWithStrVal(""), Value_Which_StrVal, "str_val"},
+		{NewValueWithPointVal(&Point{X: 1}), Value_Which_PointVal, "point_val"},
+		{NewValueWithListVal([]string{"a"}), Value_Which_ListVal, "list_val"},
+	}
+	for _, c := range cases {
+		if w := c.v.Which(); w != c.which || w.String() != c.name {
+			t.Fatalf("%v: which = %v, want %v", c.v, w, c.name)
+		}
+	}
+
+	// the constructors set the field of the given value
+	if v := NewValueWithIntVal(3); v.GetIntVal() != 3 || v.CountSetFields() != 1 {
+		t.Fatalf("unexpected union: %v", v)
+	}
+	if p := (&Point{Y: 2}); NewValueWithPointVal(p).PointVal != p {
+		t.Fatal("point is not set")
+	}
+}
+
+func TestWriteOneField(t *testing.T) {
+	v := NewValueWithStrVal("a")
+	data, err := thrift.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &Value{}
+	if err = thrift.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equals(v) || got.Which() != Value_Which_StrVal {
+		t.Fatalf("%v != %v", got, v)
+	}
+
+	s := "b"
+	for _, v := range []*Value{{}, {StrVal: &s, ListVal: []string{"c"}}} {
+		if _, err := thrift.Marshal(v); !isInvalidData(err) {
+			t.Fatalf("write %v: unexpected error: %v", v, err)
+		}
+	}
+}
+
+func TestReadOneField(t *testing.T) {
+	i, s := int64(1), "a"
+	for _, p := range []*Pair{{}, {IntVal: &i, StrVal: &s}} {
+		data, err := thrift.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = thrift.Unmarshal(data, &Value{}); !isInvalidData(err) {
+			t.Fatalf("read %v: unexpected error: %v", p, err)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		v    *Value
+		want string
+	}{
+		{&Value{}, `{}`},
+		{NewValueWithIntVal(0), `{"int_val":0}`},
+		{NewValueWithStrVal("a"), `{"str_val":"a"}`},
+		{NewValueWithPointVal(&Point{X: 1}), `{"point_val":{"x":1,"y":0}}`},
+		{NewValueWithListVal([]string{}), `{"list_val":[]}`},
+	}
+	for _, c := range cases {
+		data, err := json.Marshal(c.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != c.want {
+			t.Fatalf("json = %s, want %s", data, c.want)
+		}
+	}
+}
+
+func isInvalidData(err error) bool {
+	ex, ok := err.(*thrift.ApplicationException)
+	return ok && ex.TypeID() == thrift.INVALID_DATA
+}
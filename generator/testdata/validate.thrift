@@ -0,0 +1,32 @@
+namespace go validate
+
+enum Color {
+    RED = 1
+    GREEN = 2
+}
+
+struct Point {
+    1: i32 x (vt.min = "0")
+}
+
+// Tag is used by encoded keys, since its optional field is a pointer.
+struct Tag {
+    1: optional string name (vt.min_len = "1")
+}
+
+struct Range {
+    1: i64 start (vt.min = "0")
+    2: i64 end (vt.min = "$start")
+    3: optional i64 limit (vt.max = "$end")
+    4: optional Color color (vt.defined_only = "true")
+    5: Color kind (vt.defined_only = "true")
+    6: Point origin (vt.not_nil = "true")
+    7: list<Point> points
+    8: set<Point> point_set
+    9: map<Point, i32> by_point
+    10: map<Tag, list<Point>> by_tag
+}
+
+service Ranges {
+    Range Echo(1: Range req)
+}
@@ -0,0 +1,110 @@
+package validate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+func validRange() *Range {
+	limit, color, name := int64(2), Color_GREEN, "a"
+	return &Range{
+		Start: 1, End: 3, Limit: &limit, Color: &color, Kind: Color_RED,
+		Origin: &Point{}, Points: []*Point{{X: 1}},
+		PointSet: map[Point]bool{{X: 2}: true},
+		ByPoint:  map[Point]int32{{X: 3}: 3},
+		ByTag:    map[TagKey][]*Point{(&Tag{Name: &name}).Key(): {{X: 4}}},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validRange().Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the optional fields are not checked if they are unset
+	r := validRange()
+	r.Limit, r.Color = nil, nil
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		field  string
+		modify func(r *Range)
+	}{
+		{"Range.start", func(r *Range) { r.Start = -1 }},
+		{"Range.end", func(r *Range) { r.End = 0 }},
+		{"Range.limit", func(r *Range) { *r.Limit = 4 }},
+		{"Range.color", func(r *Range) { *r.Color = 3 }},
+		{"Range.kind", func(r *Range) { r.Kind = 0 }},
+		{"Range.origin", func(r *Range) { r.Origin = nil }},
+		{"Point.x", func(r *Range) { r.Origin.X = -1 }},
+		{"Point.x", func(r *Range) { r.Points[0].X = -1 }},
+		// set elements and map keys are validated as well
+		{"Point.x", func(r *Range) { r.PointSet[Point{X: -1}] = true }},
+		{"Point.x", func(r *Range) { r.ByPoint[Point{X: -1}] = 0 }},
+		{"Tag.name", func(r *Range) { r.ByTag[(&Tag{Name: new(string)}).Key()] = nil }},
+		{"Point.x", func(r *Range) { r.ByTag[(&Tag{}).Key()] = []*Point{{X: -1}} }},
+	} {
+		r := validRange()
+		c.modify(r)
+		var verr *thrift.ValidationError
+		if err := r.Validate(); !errors.As(err, &verr) || verr.Field != c.field {
+			t.Errorf("%v: %v", c.field, err)
+		}
+	}
+}
+
+func TestProcessHttpValidation(t *testing.T) {
+	p := NewRangesProcessor(RangesHandlerFuncs{
+		EchoFunc: func(ctx context.Context, req *Range) (*Range, error) {
+			return req, nil
+		},
+	})
+	post := func(req *Range) (int, []byte) {
+		args := NewRangesEchoArgs()
+		args.Req = req
+		body, err := thrift.Marshal(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-thrift")
+		r.Header.Set("X-Rpc-Method", "Echo")
+		w := httptest.NewRecorder()
+		if err = p.ProcessHttp(context.Background(), r, w); err != nil {
+			t.Fatal(err)
+		}
+		rsp, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, rsp
+	}
+
+	invalid := validRange()
+	invalid.End = 0
+	if code, _ := post(invalid); code != http.StatusOK {
+		t.Fatal("validated without SetValidation", code)
+	}
+
+	p.SetValidation(true)
+	if code, _ := post(validRange()); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	code, rsp := post(invalid)
+	if code != http.StatusBadRequest {
+		t.Fatal(code)
+	}
+	ex := &thrift.ApplicationException{}
+	if err := thrift.Unmarshal(rsp, ex); err != nil {
+		t.Fatal(err)
+	}
+	if ex.TypeID() != thrift.INVALID_DATA {
+		t.Fatal(ex)
+	}
+}
@@ -0,0 +1,47 @@
+namespace go values
+
+typedef binary Blob
+typedef i32 Count
+
+enum Color {
+    RED = 1
+    GREEN = 2
+}
+
+struct Point {
+    1: i32 x
+    2: i32 y = 2
+}
+
+union Value {
+    1: i64 int_val
+    2: string str_val
+}
+
+const i32 NUM = 10
+const Count COUNT = 3
+const double RATIO = 0.5
+const bool FLAG = 1
+const string NAME = "name"
+const binary DATA = "data"
+const Blob BLOB = "blob"
+const Color COLOR = Color.GREEN
+const list<string> NAMES = ["a", NAME]
+const set<i32> IDS = [1, 2]
+const map<string, Color> COLORS = {"red": Color.RED, "green": COLOR}
+const Point ORIGIN = {"x": 1}
+const list<Point> POINTS = [ORIGIN, {"x": 3, "y": 4}]
+const Value VALUE = {"str_val": "v"}
+
+struct Defaults {
+    1: optional binary b = "bb"
+    2: optional Blob blob = "blob"
+    3: binary data = DATA
+    4: optional i32 num = NUM
+    5: Count count = COUNT
+    6: optional Color color = Color.GREEN
+    7: Point origin = ORIGIN
+    8: optional list<string> names = NAMES
+    9: map<string, Color> colors = {"red": Color.RED}
+    10: optional string name = "n"
+}
@@ -0,0 +1,69 @@
+package values
+
+import (
+	"testing"
+)
+
+func TestConstants(t *testing.T) {
+	if NUM != 10 || COUNT != 3 || RATIO != 0.5 || !FLAG || NAME != "name" || COLOR != Color_GREEN {
+		t.Fatal(NUM, COUNT, RATIO, FLAG, NAME, COLOR)
+	}
+	if string(DATA) != "data" || string(BLOB) != "blob" {
+		t.Fatal(DATA, BLOB)
+	}
+	if len(NAMES) != 2 || NAMES[1] != "name" || len(IDS) != 2 || COLORS["green"] != Color_GREEN {
+		t.Fatal(NAMES, IDS, COLORS)
+	}
+	// the omitted fields take their default values
+	if ORIGIN.X != 1 || ORIGIN.Y != 2 {
+		t.Fatal(ORIGIN)
+	}
+	// the struct constants are not shared
+	if !POINTS[0].Equals(ORIGIN) || POINTS[0] == ORIGIN || POINTS[1].Y != 4 {
+		t.Fatal(POINTS)
+	}
+	if VALUE.GetStrVal() != "v" || VALUE.IsSetIntVal() {
+		t.Fatal(VALUE)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	p := NewDefaults()
+	if string(p.B) != "bb" || string(p.Blob) != "blob" || string(p.Data) != "data" {
+		t.Fatal(p)
+	}
+	if p.GetNum() != 10 || p.Count != 3 || p.GetColor() != Color_GREEN || p.GetName() != "n" {
+		t.Fatal(p)
+	}
+	if !p.Origin.Equals(ORIGIN) || p.Origin == ORIGIN || len(p.Names) != 2 || p.Colors["red"] != Color_RED {
+		t.Fatal(p)
+	}
+
+	// the defaults are not shared
+	p.Names[0] = "b"
+	p.Data[0] = 'x'
+	if NAMES[0] != "a" || string(DATA) != "data" {
+		t.Fatal(NAMES, DATA)
+	}
+
+	// the unset optional fields return their default values
+	q := &Defaults{}
+	if q.IsSetB() || q.IsSetBlob() || q.IsSetNames() {
+		t.Fatal(q)
+	}
+	if string(q.GetB()) != "bb" || string(q.GetBlob()) != "blob" || len(q.GetNames()) != 2 {
+		t.Fatal(q)
+	}
+	// the optional fields of base types are set if they are not default
+	if !q.IsSetNum() || q.GetNum() != 0 {
+		t.Fatal(q)
+	}
+	q.Num = 10
+	if q.IsSetNum() {
+		t.Fatal(q)
+	}
+	q.B = []byte{}
+	if !q.IsSetB() || len(q.GetB()) != 0 {
+		t.Fatal(q)
+	}
+}
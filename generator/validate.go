@@ -0,0 +1,220 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+// Validation annotations supported by the generated Validate methods:
+//
+//	vt.min, vt.max, vt.gt, vt.lt    numeric range, the bound is a number or
+//	                                "$field" referring to another field
+//	vt.min_len, vt.max_len          length of string or binary
+//	vt.pattern                      regular expression of string or binary
+//	vt.min_size, vt.max_size        size of list, set or map
+//	vt.defined_only                 enum value must be defined
+//	vt.not_nil                      struct must be set
+//
+// Nested structs, including the elements of lists and sets and the keys and
+// values of maps, are always validated.
+const validatePrefix = "vt."
+
+var rangeOps = map[string]struct{ op, desc string }{
+	"vt.min": {"<", ">="},
+	"vt.max": {">", "<="},
+	"vt.gt":  {"<=", ">"},
+	"vt.lt":  {">=", "<"},
+}
+
+// validateField returns statements which return a *thrift.ValidationError
+// if the field of p violates its annotations.
+func (g *Generator) validateField(s *parser.Struct, field *parser.Field) (string, error) {
+	name := g.fieldName(field)
+	path := ToCamelCase(s.Name) + "." + field.Name
+	typ := g.underlyingType(field.Type)
+	isStruct := g.isPtrType(field.Type)
+
+	fail := func(reason string, args ...interface{}) string {
+		reason = fmt.Sprintf(reason, args...)
+		return fmt.Sprintf("return &thrift.ValidationError{Field: %q, Reason: %q}", path, reason)
+	}
+	badType := func(a *parser.Annotation) error {
+		return fmt.Errorf("%v: annotation %v is not applicable to type %v", path, a.Name, field.Type)
+	}
+
+	// x is the value of the field, which is dereferenced if the field
+	// is a pointer to basic type or enum.
+	x := "p." + name
+	if g.isPtrValueField(field) {
+		x = "*p." + name
+	}
+	var checks []string
+	var notNil bool
+	for _, a := range field.Annotations {
+		if !strings.HasPrefix(a.Name, validatePrefix) {
+			continue
+		}
+		switch a.Name {
+		case "vt.min", "vt.max", "vt.gt", "vt.lt":
+			if !isNumeric(typ) {
+				return "", badType(a)
+			}
+			op := rangeOps[a.Name]
+			if strings.HasPrefix(a.Value, "$") {
+				other := findField(s, a.Value[1:])
+				if other == nil {
+					return "", fmt.Errorf("%v: annotation %v refers to unknown field %v", path, a.Name, a.Value)
+				}
+				y, guard := "p."+g.fieldName(other), ""
+				if g.isPtrValueField(other) {
+					guard = y + " != nil && "
+					y = "*" + y
+				}
+				cond := fmt.Sprintf("%v%v %v %v", guard, x, op.op, y)
+				checks = append(checks, fmt.Sprintf("if %v { %v }", cond, fail("must be %v %v", op.desc, other.Name)))
+				continue
+			}
+			if _, err := strconv.ParseFloat(a.Value, 64); err != nil {
+				return "", fmt.Errorf("%v: annotation %v: invalid number %q", path, a.Name, a.Value)
+			}
+			cond := fmt.Sprintf("%v %v %v", x, op.op, a.Value)
+			checks = append(checks, fmt.Sprintf("if %v { %v }", cond, fail("must be %v %v", op.desc, a.Value)))
+		case "vt.min_len", "vt.max_len":
+			if typ.Category != parser.TypeBasic || (typ.TType() != parser.STRING && typ.TType() != parser.BINARY) {
+				return "", badType(a)
+			}
+			n, err := strconv.Atoi(a.Value)
+			if err != nil || n < 0 {
+				return "", fmt.Errorf("%v: annotation %v: invalid length %q", path, a.Name, a.Value)
+			}
+			if a.Name == "vt.min_len" {
+				checks = append(checks, fmt.Sprintf("if len(%v) < %d { %v }", x, n, fail("length must be at least %d", n)))
+			} else {
+				checks = append(checks, fmt.Sprintf("if len(%v) > %d { %v }", x, n, fail("length must be at most %d", n)))
+			}
+		case "vt.pattern":
+			if typ.Category != parser.TypeBasic || (typ.TType() != parser.STRING && typ.TType() != parser.BINARY) {
+				return "", badType(a)
+			}
+			if _, err := regexp.Compile(a.Value); err != nil {
+				return "", fmt.Errorf("%v: annotation %v: %v", path, a.Name, err)
+			}
+			cond := fmt.Sprintf("!thrift.MatchPattern(%q, string(%v))", a.Value, x)
+			checks = append(checks, fmt.Sprintf("if %v { %v }", cond, fail("must match %v", a.Value)))
+		case "vt.min_size", "vt.max_size":
+			if typ.Category != parser.TypeContainer {
+				return "", badType(a)
+			}
+			n, err := strconv.Atoi(a.Value)
+			if err != nil || n < 0 {
+				return "", fmt.Errorf("%v: annotation %v: invalid size %q", path, a.Name, a.Value)
+			}
+			if a.Name == "vt.min_size" {
+				checks = append(checks, fmt.Sprintf("if len(%v) < %d { %v }", x, n, fail("size must be at least %d", n)))
+			} else {
+				checks = append(checks, fmt.Sprintf("if len(%v) > %d { %v }", x, n, fail("size must be at most %d", n)))
+			}
+		case "vt.defined_only":
+			if _, ok := typ.GetFinalType().(*parser.Enum); !ok || typ.Category != parser.TypeIdentifier {
+				return "", badType(a)
+			}
+			if a.Value != "false" && a.Value != "0" {
+				enum := x
+				if g.isPtrValueField(field) {
+					enum = "(" + x + ")"
+				}
+				cond := fmt.Sprintf("%v.String() == \"<UNSET>\"", enum)
+				checks = append(checks, fmt.Sprintf("if %v { %v }", cond, fail("undefined enum value")))
+			}
+		case "vt.not_nil":
+			if !isStruct {
+				return "", badType(a)
+			}
+			notNil = a.Value != "false" && a.Value != "0"
+		default:
+			return "", fmt.Errorf("%v: unknown annotation %v", path, a.Name)
+		}
+	}
+
+	var buf strings.Builder
+	if notNil {
+		fmt.Fprintf(&buf, "if p.%v == nil { %v }\n", name, fail("must be set"))
+	}
+	if len(checks) > 0 {
+		if g.isPtrValueField(field) {
+			fmt.Fprintf(&buf, "if p.%v != nil {\n%v\n}\n", name, strings.Join(checks, "\n"))
+		} else {
+			buf.WriteString(strings.Join(checks, "\n") + "\n")
+		}
+	}
+	buf.WriteString(g.validateNested(field.Type, "p."+name, 0))
+	return buf.String(), nil
+}
+
+// validateNested returns statements which validate the structs in value x
+// of type typ, it returns empty string if there is no struct in typ.
+func (g *Generator) validateNested(typ *parser.Type, x string, depth int) string {
+	if g.isPtrType(typ) {
+		return fmt.Sprintf("if %[1]v != nil {\n if err := %[1]v.Validate(); err != nil { return err }\n }\n", x)
+	}
+	typ = g.underlyingType(typ)
+	if typ.Category != parser.TypeContainer {
+		return ""
+	}
+	k, v := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+	var key, elem string
+	switch g.containerKind(typ) {
+	case "list":
+		elem = g.validateNested(typ.ValueType, v, depth+1)
+	case "set":
+		key = g.validateKey(typ.ValueType, k)
+	default:
+		key = g.validateKey(typ.KeyType, k)
+		elem = g.validateNested(typ.ValueType, v, depth+1)
+	}
+	switch {
+	case key != "" && elem != "":
+		return fmt.Sprintf("for %v, %v := range %v {\n %v %v }\n", k, v, x, key, elem)
+	case key != "":
+		return fmt.Sprintf("for %v := range %v {\n %v }\n", k, x, key)
+	case elem != "":
+		return fmt.Sprintf("for _, %v := range %v {\n %v }\n", v, x, elem)
+	}
+	return ""
+}
+
+// validateKey is like validateNested, but validates the map key or set
+// element x, which is a struct value or an encoded key if it is a struct.
+func (g *Generator) validateKey(typ *parser.Type, x string) string {
+	if !g.isPtrType(typ) {
+		return ""
+	}
+	if g.isEncodedKey(typ) {
+		return fmt.Sprintf("if x, err := %v.Struct(); err != nil { return err } else if err := x.Validate(); err != nil { return err }\n", x)
+	}
+	return fmt.Sprintf("if err := %v.Validate(); err != nil { return err }\n", x)
+}
+
+func isNumeric(typ *parser.Type) bool {
+	if typ.Category != parser.TypeBasic {
+		return false
+	}
+	switch typ.TType() {
+	case parser.BYTE, parser.I16, parser.I32, parser.I64, parser.DOUBLE, parser.FLOAT:
+		return true
+	}
+	return false
+}
+
+func findField(s *parser.Struct, name string) *parser.Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
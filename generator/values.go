@@ -0,0 +1,315 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jxskiss/thriftkit/parser"
+)
+
+// formatValue returns Go expression of the constant value of type typ,
+// which is written in document d, e.g. a constant or a field default.
+// Structs are formatted as composite literals, where the omitted fields
+// take their default values.
+func (g *Generator) formatValue(d *parser.Document, typ *parser.Type, value interface{}) (string, error) {
+	return g.formatValueIn(d, d, typ, value, false)
+}
+
+// formatValueIn formats value written in document src to be used in the
+// package of document out, struct values are formatted as pointers
+// unless byValue is true, which is used for map keys and set elements.
+func (g *Generator) formatValueIn(out, src *parser.Document, typ *parser.Type, value interface{}, byValue bool) (string, error) {
+	if c, ok := value.(parser.ConstValue); ok && c.Type == parser.ConstTypeIdentifier &&
+		c.Value != "true" && c.Value != "false" {
+		ref := g.formatConstRef(out, src, c.Value)
+		if g.isPtrType(typ) {
+			// struct constants are pointers, which must not be shared
+			if byValue {
+				return "*" + ref, nil
+			}
+			return ref + ".DeepCopy()", nil
+		}
+		if !typ.IsValueType() {
+			// nor are the containers and binaries, which are formatted
+			// from the value of the constant again
+			if ref, rsrc := g.lookupConst(src, c.Value); ref != nil {
+				return g.formatValueIn(out, rsrc, typ, ref.Value, byValue)
+			}
+		}
+		return ref, nil
+	}
+	gotype, err := g.formatType(g.qualifyType(out, typ))
+	if err != nil {
+		return "", err
+	}
+	mismatch := func() error {
+		return fmt.Errorf("invalid value %v for type %v", value, typ)
+	}
+	if g.isPtrType(typ) {
+		entries, ok := value.([]parser.MapConstValue)
+		if !ok {
+			return "", mismatch()
+		}
+		lit, err := g.formatStructValue(out, src, typ, entries)
+		if err != nil {
+			return "", err
+		}
+		if !byValue {
+			return "&" + gotype + lit, nil
+		}
+		return gotype + lit, nil
+	}
+
+	u := g.underlyingType(typ)
+	switch u.Category {
+	case parser.TypeBasic:
+		c, ok := value.(parser.ConstValue)
+		if !ok {
+			return "", mismatch()
+		}
+		switch u.TType() {
+		case parser.STRING:
+			return fmt.Sprintf("%q", c.Value), nil
+		case parser.BINARY:
+			return fmt.Sprintf("%v(%q)", gotype, c.Value), nil
+		case parser.BOOL:
+			if c.Type == parser.ConstTypeInt {
+				return fmt.Sprint(c.Value != "0"), nil
+			}
+		}
+		return c.Value, nil
+	case parser.TypeIdentifier: // enum
+		c, ok := value.(parser.ConstValue)
+		if !ok {
+			return "", mismatch()
+		}
+		return c.Value, nil
+	case parser.TypeContainer:
+		var elems []string
+		switch u.Name {
+		case "list", "set":
+			values, ok := value.(parser.ListConstValue)
+			if !ok {
+				return "", mismatch()
+			}
+			isMap := u.Name == "set" && g.setKind(u) != SetSlice
+			for _, v := range values {
+				elem, err := g.formatValueIn(out, src, u.ValueType, v, isMap)
+				if err != nil {
+					return "", err
+				}
+				if isMap && g.isEncodedKey(u.ValueType) {
+					elem = formatKey(elem)
+				}
+				switch {
+				case !isMap:
+				case g.setKind(u) == SetTyped:
+					elem += ": {}"
+				default:
+					elem += ": true"
+				}
+				elems = append(elems, elem)
+			}
+		case "map":
+			entries, ok := value.([]parser.MapConstValue)
+			if !ok {
+				return "", mismatch()
+			}
+			for _, e := range entries {
+				k, err := g.formatValueIn(out, src, u.KeyType, e.Key, true)
+				if err != nil {
+					return "", err
+				}
+				if g.isEncodedKey(u.KeyType) {
+					k = formatKey(k)
+				}
+				v, err := g.formatValueIn(out, src, u.ValueType, e.Value, false)
+				if err != nil {
+					return "", err
+				}
+				elems = append(elems, k+": "+v)
+			}
+		}
+		return gotype + "{" + strings.Join(elems, ", ") + "}", nil
+	}
+	return "", mismatch()
+}
+
+// formatStructValue returns the body of composite literal of a struct,
+// union or exception type, e.g. `{X: 1, Y: 2}`.
+func (g *Generator) formatStructValue(out, src *parser.Document, typ *parser.Type, entries []parser.MapConstValue) (string, error) {
+	var s *parser.Struct
+	switch x := typ.GetFinalType().(type) {
+	case *parser.Struct:
+		s = x
+	case *parser.Union:
+		s = (*parser.Struct)(x)
+	}
+	given := make(map[string]interface{}, len(entries))
+	for _, e := range entries {
+		c, ok := e.Key.(parser.ConstValue)
+		if !ok || findField(s, c.Value) == nil {
+			return "", fmt.Errorf("struct %v has no field %v", s.Name, e.Key)
+		}
+		given[c.Value] = e.Value
+	}
+	sdoc := g.findDocument(s)
+	var fields []string
+	for _, f := range s.Fields {
+		v, vsrc := given[f.Name], src
+		if v == nil {
+			// omitted fields take their default values
+			if f.Default == nil || f.IsDefaultZero() {
+				continue
+			}
+			v, vsrc = f.Default, sdoc
+		}
+		expr, err := g.formatValueIn(out, vsrc, f.Type, v, false)
+		if err != nil {
+			return "", err
+		}
+		if g.isPtrValueField(f) {
+			expr = g.formatPtr(out, f.Type, expr)
+		}
+		fields = append(fields, g.fieldName(f)+": "+expr)
+	}
+	return "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+// formatPtr returns expression of pointer to value expr of basic type or
+// enum typ.
+func (g *Generator) formatPtr(out *parser.Document, typ *parser.Type, expr string) string {
+	t := g.resolveAlias(typ)
+	if t.Category == parser.TypeBasic {
+		name, _ := g.formatType(t)
+		if t.TType() == parser.BINARY {
+			name = "byteSlice"
+		}
+		return fmt.Sprintf("thrift.%v%vPtr(%v)", strings.ToUpper(name[:1]), name[1:], expr)
+	}
+	name, _ := g.formatType(g.qualifyType(out, t))
+	if _, ok := t.GetFinalType().(*parser.Enum); ok || g.lookupTypedef(t) != nil {
+		// enums and defined typedefs have generated Ptr functions
+		return fmt.Sprintf("%vPtr(%v)", name, expr)
+	}
+	// custom types specified by annotation "go.type"
+	return fmt.Sprintf("func(v %[1]v) *%[1]v { return &v }(%[2]v)", name, expr)
+}
+
+// formatConstRef returns Go name of the constant or enum value ref, e.g.
+// "MAX", "inc.MAX", "Color.RED" or "inc.Color.RED", which is referred in
+// document src and used in the package of document out.
+func (g *Generator) formatConstRef(out, src *parser.Document, ref string) string {
+	parts := strings.Split(ref, ".")
+	prefix := ""
+	if inc := src.Includes[parts[0]]; len(parts) > 1 && inc != nil && g.ImportedPkgs[inc.AbsPath] != nil {
+		prefix = g.ImportedPkgs[inc.AbsPath].Name() + "."
+		parts = parts[1:]
+	} else if src.Filename != out.Filename {
+		if pkg := g.findPackage(src.Filename); pkg != nil {
+			prefix = pkg.Name() + "."
+		}
+	}
+	if len(parts) == 2 {
+		// enum value
+		return prefix + ToCamelCase(parts[0]) + "_" + parts[1]
+	}
+	return prefix + strings.Join(parts, "_")
+}
+
+// formatKey returns the encoded key of struct value expr formatted by
+// value, e.g. "(&Point{X: 1}).Key()".
+func formatKey(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		// constant
+		return expr[1:] + ".Key()"
+	}
+	return "(&" + expr + ").Key()"
+}
+
+// lookupConst returns the constant ref referred in document src, and the
+// document which defines it, or nil if ref is not a constant.
+func (g *Generator) lookupConst(src *parser.Document, ref string) (*parser.Constant, *parser.Document) {
+	doc, name := src, ref
+	if parts := strings.SplitN(ref, ".", 2); len(parts) == 2 {
+		inc := src.Includes[parts[0]]
+		if inc == nil || g.ImportedPkgs[inc.AbsPath] == nil {
+			return nil, nil
+		}
+		doc, name = g.ImportedPkgs[inc.AbsPath].Document, parts[1]
+	}
+	for _, c := range doc.Constants {
+		if c.Name == name {
+			return c, doc
+		}
+	}
+	return nil, nil
+}
+
+// qualifyType returns a copy of typ whose identifiers are qualified by
+// package name if they are defined in other documents than out, so that
+// typ can be formatted in the package of out.
+func (g *Generator) qualifyType(out *parser.Document, typ *parser.Type) *parser.Type {
+	switch typ.Category {
+	case parser.TypeContainer:
+		t := *typ
+		if t.KeyType != nil {
+			t.KeyType = g.qualifyType(out, t.KeyType)
+		}
+		t.ValueType = g.qualifyType(out, t.ValueType)
+		return &t
+	case parser.TypeIdentifier:
+		if typ.D == nil || typ.D.Filename == out.Filename || strings.Contains(typ.Name, ".") {
+			return typ
+		}
+		pkg := g.findPackage(typ.D.Filename)
+		if pkg == nil {
+			return typ
+		}
+		t := *typ
+		t.FinalType = typ.GetFinalType()
+		t.Name = pkg.Name() + "." + typ.Name
+		t.D = &parser.Document{}
+		return &t
+	}
+	return typ
+}
+
+// findPackage returns the package of thrift file filename.
+func (g *Generator) findPackage(filename string) *Package {
+	if g.RootPkg.Filename == filename {
+		return g.RootPkg
+	}
+	for _, pkg := range g.ImportedPkgs {
+		if pkg.Filename == filename {
+			return pkg
+		}
+	}
+	return nil
+}
+
+// findDocument returns the document which defines struct s.
+func (g *Generator) findDocument(s *parser.Struct) *parser.Document {
+	pkgs := []*Package{g.RootPkg}
+	for _, pkg := range g.ImportedPkgs {
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		for _, x := range pkg.Structs {
+			if x == s {
+				return pkg.Document
+			}
+		}
+		for _, x := range pkg.Exceptions {
+			if x == s {
+				return pkg.Document
+			}
+		}
+		for _, x := range pkg.Unions {
+			if (*parser.Struct)(x) == s {
+				return pkg.Document
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,491 @@
+package kit
+
+import (
+	"context"
+	"hash/crc32"
+	"io"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/go-kit/kit/sd/lb"
+)
+
+// ParseInstance splits an instance string reported by service discovery
+// into address and metadata. Metadata is encoded as URL query following
+// the address, e.g. "10.0.0.1:8080?weight=10&zone=a".
+func ParseInstance(instance string) (address string, meta map[string]string) {
+	i := strings.IndexByte(instance, '?')
+	if i < 0 {
+		return instance, nil
+	}
+	address = instance[:i]
+	values, _ := url.ParseQuery(instance[i+1:])
+	meta = make(map[string]string, len(values))
+	for k := range values {
+		meta[k] = values.Get(k)
+	}
+	return address, meta
+}
+
+// FormatInstance is the reverse of ParseInstance.
+func FormatInstance(address string, meta map[string]string) string {
+	if len(meta) == 0 {
+		return address
+	}
+	values := make(url.Values, len(meta))
+	for k, v := range meta {
+		values.Set(k, v)
+	}
+	return address + "?" + values.Encode()
+}
+
+// Node is an instance of service to be picked by LoadBalancer.
+type Node struct {
+	Instance string
+	Address  string
+	Weight   int // from metadata "weight", defaults to 1
+	Metadata map[string]string
+
+	endpoint    endpoint.Endpoint
+	closer      io.Closer
+	breaker     *breaker // nil if circuit breaker is not enabled
+	outstanding int64    // atomic
+	latency     int64    // atomic, moving average in nanoseconds
+}
+
+// Outstanding returns the number of requests in flight.
+func (n *Node) Outstanding() int64 {
+	return atomic.LoadInt64(&n.outstanding)
+}
+
+// Latency returns the moving average of observed latency, it is zero
+// before the first request finishes.
+func (n *Node) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&n.latency))
+}
+
+// State returns the state of circuit breaker of the node, it is always
+// BreakerClosed if circuit breaker is not enabled.
+func (n *Node) State() BreakerState {
+	if n.breaker == nil {
+		return BreakerClosed
+	}
+	return n.breaker.State()
+}
+
+func (n *Node) available() bool {
+	return n.breaker == nil || n.breaker.available()
+}
+
+func (n *Node) call(ctx context.Context, request interface{}) (interface{}, error) {
+	var probe bool
+	if n.breaker != nil {
+		var ok bool
+		if ok, probe = n.breaker.allow(); !ok {
+			return nil, ErrCircuitOpen
+		}
+	}
+	atomic.AddInt64(&n.outstanding, 1)
+	begin := time.Now()
+	response, err := n.endpoint(ctx, request)
+	n.observe(time.Since(begin))
+	atomic.AddInt64(&n.outstanding, -1)
+	if n.breaker != nil {
+		n.breaker.record(err, probe)
+	}
+	return response, err
+}
+
+func (n *Node) observe(d time.Duration) {
+	for {
+		old := atomic.LoadInt64(&n.latency)
+		avg := int64(d)
+		if old > 0 {
+			avg = old - old/8 + avg/8
+		}
+		if atomic.CompareAndSwapInt64(&n.latency, old, avg) {
+			return
+		}
+	}
+}
+
+// LoadBalancer picks a node for each request. Unlike lb.Balancer, it sees
+// the request and the nodes' state to make the decision.
+type LoadBalancer interface {
+	Pick(ctx context.Context, request interface{}, nodes []*Node) (*Node, error)
+}
+
+type hashKeyCtxKey struct{}
+
+// WithHashKey returns a new context carrying key for the consistent
+// hashing load balancer.
+func WithHashKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, hashKeyCtxKey{}, key)
+}
+
+// HashKey returns the key for consistent hashing from context, or from
+// the request if it has a HashKey method, which is generated for the
+// field annotated by "hash_key".
+func HashKey(ctx context.Context, request interface{}) string {
+	if key, ok := ctx.Value(hashKeyCtxKey{}).(string); ok {
+		return key
+	}
+	if x, ok := request.(interface{ HashKey() string }); ok {
+		return x.HashKey()
+	}
+	return ""
+}
+
+// NewWeightedRoundRobin returns a smooth weighted round robin load balancer,
+// the weights come from the "weight" metadata of instances.
+func NewWeightedRoundRobin() LoadBalancer {
+	return &weightedRoundRobin{current: make(map[*Node]int)}
+}
+
+type weightedRoundRobin struct {
+	mu      sync.Mutex
+	current map[*Node]int
+}
+
+func (b *weightedRoundRobin) Pick(ctx context.Context, request interface{}, nodes []*Node) (*Node, error) {
+	if len(nodes) == 0 {
+		return nil, lb.ErrNoEndpoints
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.current) > len(nodes) {
+		// forget nodes which are gone
+		current := make(map[*Node]int, len(nodes))
+		for _, n := range nodes {
+			current[n] = b.current[n]
+		}
+		b.current = current
+	}
+	var best *Node
+	var total int
+	for _, n := range nodes {
+		b.current[n] += n.Weight
+		total += n.Weight
+		if best == nil || b.current[n] > b.current[best] {
+			best = n
+		}
+	}
+	b.current[best] -= total
+	return best, nil
+}
+
+// NewLeastOutstanding returns a load balancer which picks the node with
+// least requests in flight.
+func NewLeastOutstanding() LoadBalancer {
+	return leastOutstanding{}
+}
+
+type leastOutstanding struct{}
+
+func (leastOutstanding) Pick(ctx context.Context, request interface{}, nodes []*Node) (*Node, error) {
+	if len(nodes) == 0 {
+		return nil, lb.ErrNoEndpoints
+	}
+	// start from a random node to spread requests among the ties
+	start := rand.Intn(len(nodes))
+	best := nodes[start]
+	for i := 1; i < len(nodes); i++ {
+		n := nodes[(start+i)%len(nodes)]
+		if n.Outstanding() < best.Outstanding() {
+			best = n
+		}
+	}
+	return best, nil
+}
+
+// NewP2C returns a "power of two choices" load balancer, it picks two nodes
+// randomly and chooses the one with lower latency times outstanding requests.
+func NewP2C() LoadBalancer {
+	return p2c{}
+}
+
+type p2c struct{}
+
+func (p2c) Pick(ctx context.Context, request interface{}, nodes []*Node) (*Node, error) {
+	switch len(nodes) {
+	case 0:
+		return nil, lb.ErrNoEndpoints
+	case 1:
+		return nodes[0], nil
+	}
+	i := rand.Intn(len(nodes))
+	j := rand.Intn(len(nodes) - 1)
+	if j >= i {
+		j++
+	}
+	a, b := nodes[i], nodes[j]
+	if p2cLoad(b) < p2cLoad(a) {
+		return b, nil
+	}
+	return a, nil
+}
+
+func p2cLoad(n *Node) int64 {
+	// nodes without latency observed yet are preferred
+	return int64(n.Latency()) * (n.Outstanding() + 1)
+}
+
+// NewConsistentHash returns a consistent hashing load balancer, which
+// places replicas virtual nodes per weight on the hash ring. keyFunc
+// defaults to HashKey, requests without key are spread randomly.
+func NewConsistentHash(replicas int, keyFunc func(ctx context.Context, request interface{}) string) LoadBalancer {
+	if replicas <= 0 {
+		replicas = 100
+	}
+	if keyFunc == nil {
+		keyFunc = HashKey
+	}
+	return &consistentHash{replicas: replicas, keyFunc: keyFunc}
+}
+
+type consistentHash struct {
+	replicas int
+	keyFunc  func(ctx context.Context, request interface{}) string
+
+	mu     sync.RWMutex
+	nodes  []*Node
+	hashes []uint32
+	owners map[uint32]*Node
+}
+
+func (b *consistentHash) Pick(ctx context.Context, request interface{}, nodes []*Node) (*Node, error) {
+	if len(nodes) == 0 {
+		return nil, lb.ErrNoEndpoints
+	}
+	key := b.keyFunc(ctx, request)
+	if key == "" {
+		return nodes[rand.Intn(len(nodes))], nil
+	}
+	b.mu.RLock()
+	if !sameNodes(b.nodes, nodes) {
+		b.mu.RUnlock()
+		b.build(nodes)
+		b.mu.RLock()
+	}
+	defer b.mu.RUnlock()
+	hash := crc32.ChecksumIEEE([]byte(key))
+	i := sort.Search(len(b.hashes), func(i int) bool { return b.hashes[i] >= hash })
+	if i == len(b.hashes) {
+		i = 0
+	}
+	return b.owners[b.hashes[i]], nil
+}
+
+func (b *consistentHash) build(nodes []*Node) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if sameNodes(b.nodes, nodes) {
+		return
+	}
+	hashes := make([]uint32, 0, len(nodes)*b.replicas)
+	owners := make(map[uint32]*Node, len(nodes)*b.replicas)
+	for _, n := range nodes {
+		for i := 0; i < b.replicas*n.Weight; i++ {
+			hash := crc32.ChecksumIEEE([]byte(strconv.Itoa(i) + n.Address))
+			if _, ok := owners[hash]; ok {
+				continue
+			}
+			owners[hash] = n
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	b.nodes = append([]*Node(nil), nodes...)
+	b.hashes, b.owners = hashes, owners
+}
+
+func sameNodes(a, b []*Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeSet subscribes to an Instancer and maintains the nodes, it works
+// like sd.DefaultEndpointer but keeps the identity and state of nodes.
+type nodeSet struct {
+	instancer sd.Instancer
+	factory   sd.Factory
+	logger    log.Logger
+	policy    *BreakerPolicy // nil if circuit breaker is not enabled
+	ch        chan sd.Event
+
+	mu     sync.RWMutex
+	cache  map[string]*Node
+	nodes  []*Node
+	err    error
+	closed bool
+}
+
+func newNodeSet(instancer sd.Instancer, factory sd.Factory, logger log.Logger, policy *BreakerPolicy) *nodeSet {
+	s := &nodeSet{
+		instancer: instancer,
+		factory:   factory,
+		logger:    logger,
+		policy:    policy,
+		ch:        make(chan sd.Event, 1),
+		cache:     make(map[string]*Node),
+	}
+	instancer.Register(s.ch)
+	// apply the initial state before return if it is available
+	select {
+	case event := <-s.ch:
+		s.update(event)
+	default:
+	}
+	go s.receive()
+	return s
+}
+
+func (s *nodeSet) receive() {
+	for event := range s.ch {
+		s.update(event)
+	}
+}
+
+func (s *nodeSet) update(event sd.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	if event.Err != nil {
+		// keep the nodes, assuming they are still good
+		s.logger.Log("err", event.Err)
+		s.err = event.Err
+		return
+	}
+	cache := make(map[string]*Node, len(event.Instances))
+	nodes := make([]*Node, 0, len(event.Instances))
+	for _, instance := range event.Instances {
+		if n, ok := s.cache[instance]; ok {
+			cache[instance] = n
+			nodes = append(nodes, n)
+			delete(s.cache, instance)
+			continue
+		}
+		ep, closer, err := s.factory(instance)
+		if err != nil {
+			s.logger.Log("instance", instance, "err", err)
+			continue
+		}
+		address, meta := ParseInstance(instance)
+		weight, _ := strconv.Atoi(meta["weight"])
+		if weight < 1 {
+			weight = 1
+		}
+		n := &Node{
+			Instance: instance,
+			Address:  address,
+			Weight:   weight,
+			Metadata: meta,
+			endpoint: ep,
+			closer:   closer,
+		}
+		if s.policy != nil {
+			n.breaker = s.newBreaker(n)
+		}
+		cache[instance] = n
+		nodes = append(nodes, n)
+	}
+	// close the nodes which are gone
+	for _, n := range s.cache {
+		if n.closer != nil {
+			n.closer.Close()
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Instance < nodes[j].Instance })
+	s.cache, s.nodes, s.err = cache, nodes, nil
+}
+
+func (s *nodeSet) newBreaker(n *Node) *breaker {
+	canEject := func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		var ejected int
+		for _, x := range s.nodes {
+			if x != n && x.breaker.isEjected() {
+				ejected++
+			}
+		}
+		max := s.policy.MaxEjectionPercent
+		return max <= 0 || ejected == 0 || (ejected+1)*100 <= max*len(s.nodes)
+	}
+	notify := func(from, to BreakerState) {
+		s.logger.Log("instance", n.Instance, "breaker", to)
+		if s.policy.OnStateChange != nil {
+			s.policy.OnStateChange(n.Instance, from, to)
+		}
+	}
+	return newBreaker(s.policy, canEject, notify)
+}
+
+// All returns all the nodes, including those ejected by circuit breaker.
+func (s *nodeSet) All() []*Node {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.nodes
+}
+
+// Nodes returns the nodes available to be picked, ErrCircuitOpen is
+// returned if all nodes are ejected by circuit breaker.
+func (s *nodeSet) Nodes() ([]*Node, error) {
+	s.mu.RLock()
+	nodes, err := s.nodes, s.err
+	s.mu.RUnlock()
+	if s.policy == nil {
+		return nodes, err
+	}
+	available := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.available() {
+			available = append(available, n)
+		}
+	}
+	if len(available) == 0 && len(nodes) > 0 {
+		return nil, ErrCircuitOpen
+	}
+	return available, err
+}
+
+// Endpoints implements sd.Endpointer.
+func (s *nodeSet) Endpoints() ([]endpoint.Endpoint, error) {
+	nodes, err := s.Nodes()
+	endpoints := make([]endpoint.Endpoint, len(nodes))
+	for i, n := range nodes {
+		endpoints[i] = n.call
+	}
+	return endpoints, err
+}
+
+func (s *nodeSet) Close() {
+	s.instancer.Deregister(s.ch)
+	close(s.ch)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, n := range s.cache {
+		if n.closer != nil {
+			n.closer.Close()
+		}
+	}
+	s.cache, s.nodes, s.closed = nil, nil, true
+}
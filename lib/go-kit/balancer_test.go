@@ -0,0 +1,139 @@
+package kit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/sd/lb"
+	"github.com/matryer/is"
+)
+
+func TestParseInstance(t *testing.T) {
+	is := is.New(t)
+
+	address, meta := ParseInstance("10.0.0.1:8080")
+	is.Equal(address, "10.0.0.1:8080")
+	is.Equal(meta, nil)
+
+	address, meta = ParseInstance("10.0.0.1:8080?weight=10&zone=a%26b")
+	is.Equal(address, "10.0.0.1:8080")
+	is.Equal(meta, map[string]string{"weight": "10", "zone": "a&b"})
+
+	is.Equal(FormatInstance("10.0.0.1:8080", nil), "10.0.0.1:8080")
+	instance := FormatInstance("10.0.0.1:8080", meta)
+	is.Equal(instance, "10.0.0.1:8080?weight=10&zone=a%26b")
+	address, meta2 := ParseInstance(instance)
+	is.Equal(address, "10.0.0.1:8080")
+	is.Equal(meta2, meta)
+}
+
+func testNodes(weights ...int) []*Node {
+	nodes := make([]*Node, len(weights))
+	for i, w := range weights {
+		address := fmt.Sprintf("10.0.0.%d:8080", i+1)
+		nodes[i] = &Node{Instance: address, Address: address, Weight: w}
+	}
+	return nodes
+}
+
+func TestWeightedRoundRobin(t *testing.T) {
+	is := is.New(t)
+
+	b := NewWeightedRoundRobin()
+	_, err := b.Pick(context.Background(), nil, nil)
+	is.Equal(err, lb.ErrNoEndpoints)
+
+	nodes := testNodes(5, 1, 1)
+	var picked []string
+	for i := 0; i < 7; i++ {
+		n, err := b.Pick(context.Background(), nil, nodes)
+		is.NoErr(err)
+		picked = append(picked, n.Address[7:8])
+	}
+	// smooth: the heavy node is interleaved with the others
+	is.Equal(picked, []string{"1", "1", "2", "1", "3", "1", "1"})
+
+	// the weights are kept when a node is gone
+	counts := make(map[*Node]int)
+	for i := 0; i < 60; i++ {
+		n, _ := b.Pick(context.Background(), nil, nodes[:2])
+		counts[n]++
+	}
+	is.Equal(counts[nodes[0]], 50)
+	is.Equal(counts[nodes[1]], 10)
+}
+
+func TestLeastOutstanding(t *testing.T) {
+	is := is.New(t)
+
+	nodes := testNodes(1, 1, 1)
+	nodes[0].outstanding = 3
+	nodes[1].outstanding = 1
+	nodes[2].outstanding = 2
+	b := NewLeastOutstanding()
+	for i := 0; i < 10; i++ {
+		n, err := b.Pick(context.Background(), nil, nodes)
+		is.NoErr(err)
+		is.Equal(n, nodes[1])
+	}
+}
+
+func TestP2C(t *testing.T) {
+	is := is.New(t)
+
+	b := NewP2C()
+	_, err := b.Pick(context.Background(), nil, nil)
+	is.Equal(err, lb.ErrNoEndpoints)
+	nodes := testNodes(1, 1, 1)
+	n, _ := b.Pick(context.Background(), nil, nodes[:1])
+	is.Equal(n, nodes[0])
+
+	// the slow node loses whenever it is one of the two choices
+	nodes[0].observe(time.Second)
+	nodes[1].observe(time.Millisecond)
+	nodes[2].observe(time.Millisecond)
+	counts := make(map[*Node]int)
+	for i := 0; i < 300; i++ {
+		n, err := b.Pick(context.Background(), nil, nodes)
+		is.NoErr(err)
+		counts[n]++
+	}
+	is.Equal(counts[nodes[0]], 0)
+	is.True(counts[nodes[1]] > 0)
+	is.True(counts[nodes[2]] > 0)
+}
+
+type hashKeyRequest string
+
+func (r hashKeyRequest) HashKey() string { return string(r) }
+
+func TestConsistentHash(t *testing.T) {
+	is := is.New(t)
+
+	b := NewConsistentHash(0, nil)
+	nodes := testNodes(1, 1, 1, 1)
+	owners := make(map[string]*Node)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprint(i)
+		n, err := b.Pick(context.Background(), hashKeyRequest(key), nodes)
+		is.NoErr(err)
+		owners[key] = n
+		n, _ = b.Pick(WithHashKey(context.Background(), key), nil, nodes)
+		is.Equal(n, owners[key]) // key from context
+	}
+
+	// only the keys of the removed node move to others
+	var moved int
+	for key, owner := range owners {
+		n, _ := b.Pick(context.Background(), hashKeyRequest(key), nodes[1:])
+		if owner == nodes[0] {
+			is.True(n != nodes[0])
+			moved++
+		} else {
+			is.Equal(n, owner)
+		}
+	}
+	is.True(moved > 0 && moved < 50)
+}
@@ -0,0 +1,278 @@
+package kit
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrCircuitOpen is returned when all instances are ejected by the
+// circuit breaker.
+var ErrCircuitOpen = errors.New("kit: circuit breaker is open")
+
+// BreakerPolicy configures the circuit breaker of each instance.
+//
+// An instance is ejected, i.e. skipped by the load balancer, when it fails
+// ConsecutiveFailures times in a row, or its error rate within Window
+// reaches ErrorRate. After the ejection time it becomes half-open and
+// HalfOpenProbes requests are let through to probe it, the instance is
+// recovered if all of them succeed, or ejected again for a doubled time.
+type BreakerPolicy struct {
+	// ConsecutiveFailures trips the breaker, zero disables it.
+	ConsecutiveFailures int
+
+	// ErrorRate trips the breaker if there are at least MinRequests
+	// requests within Window, zero disables it.
+	ErrorRate   float64
+	MinRequests int
+	Window      time.Duration
+
+	// EjectionTime is doubled for each consecutive ejection of an
+	// instance and limited by MaxEjectionTime.
+	EjectionTime    time.Duration
+	MaxEjectionTime time.Duration
+
+	// MaxEjectionPercent limits the percent of instances ejected at
+	// the same time, though one instance can always be ejected.
+	// Zero means no limit.
+	MaxEjectionPercent int
+
+	HalfOpenProbes int
+
+	// IsFailure reports whether an error counts as a failure of the
+	// instance, DefaultRetryable is used if it is nil, thus exceptions
+	// returned by the server don't trip the breaker.
+	IsFailure func(err error) bool
+
+	// OnStateChange is called when the state of an instance changes.
+	OnStateChange func(instance string, from, to BreakerState)
+}
+
+var DefaultBreakerPolicy = BreakerPolicy{
+	ConsecutiveFailures: 5,
+	ErrorRate:           0.5,
+	MinRequests:         20,
+	Window:              10 * time.Second,
+	EjectionTime:        5 * time.Second,
+	MaxEjectionTime:     5 * time.Minute,
+	MaxEjectionPercent:  50,
+	HalfOpenProbes:      3,
+}
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+type breaker struct {
+	policy *BreakerPolicy
+	// canEject is asked before the breaker trips from closed state,
+	// it limits the ejected instances of a nodeSet.
+	canEject func() bool
+	notify   func(from, to BreakerState)
+	ejected  int32 // atomic, 1 if not closed
+
+	mu          sync.Mutex
+	state       BreakerState
+	consecutive int
+	windowStart time.Time
+	requests    int
+	failures    int
+	ejections   int // consecutive ejections
+	ejectUntil  time.Time
+	probes      int           // probes in flight
+	successes   int           // succeeded probes
+	changes     []stateChange // to be notified after unlocking
+}
+
+type stateChange struct{ from, to BreakerState }
+
+func newBreaker(policy *BreakerPolicy, canEject func() bool, notify func(from, to BreakerState)) *breaker {
+	return &breaker{
+		policy:      policy,
+		canEject:    canEject,
+		notify:      notify,
+		windowStart: time.Now(),
+	}
+}
+
+// State returns the current state, an open breaker is reported as
+// half-open once the ejection time passed.
+func (b *breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && !time.Now().Before(b.ejectUntil) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// available reports whether the instance can be picked, without taking
+// a probe slot.
+func (b *breaker) available() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerClosed:
+		return true
+	case BreakerOpen:
+		if time.Now().Before(b.ejectUntil) {
+			return false
+		}
+	}
+	return b.probes < b.halfOpenProbes()
+}
+
+// allow is called before sending a request, it takes a probe slot if
+// the breaker is half-open, which must be returned by record.
+func (b *breaker) allow() (ok, probe bool) {
+	b.mu.Lock()
+	defer b.unlock()
+	switch b.state {
+	case BreakerClosed:
+		return true, false
+	case BreakerOpen:
+		if time.Now().Before(b.ejectUntil) {
+			return false, false
+		}
+		b.setState(BreakerHalfOpen)
+		b.probes, b.successes = 0, 0
+	}
+	if b.probes >= b.halfOpenProbes() {
+		return false, false
+	}
+	b.probes++
+	return true, true
+}
+
+func (b *breaker) record(err error, probe bool) {
+	isFailure := b.policy.IsFailure
+	if isFailure == nil {
+		isFailure = DefaultRetryable
+	}
+	failed := err != nil && isFailure(err)
+
+	b.mu.Lock()
+	defer b.unlock()
+	switch b.state {
+	case BreakerHalfOpen:
+		if !probe {
+			// sent before the instance was ejected
+			return
+		}
+		b.probes--
+		if failed {
+			b.eject()
+			return
+		}
+		if b.successes++; b.successes >= b.halfOpenProbes() {
+			b.ejections = 0
+			b.reset()
+			b.setState(BreakerClosed)
+		}
+	case BreakerClosed:
+		now := time.Now()
+		if b.policy.Window > 0 && now.Sub(b.windowStart) > b.policy.Window {
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		b.requests++
+		if !failed {
+			b.consecutive = 0
+			return
+		}
+		b.failures++
+		b.consecutive++
+		if !b.shouldTrip() {
+			return
+		}
+		if b.canEject != nil && !b.canEject() {
+			b.reset()
+			return
+		}
+		b.eject()
+	}
+}
+
+func (b *breaker) shouldTrip() bool {
+	p := b.policy
+	if p.ConsecutiveFailures > 0 && b.consecutive >= p.ConsecutiveFailures {
+		return true
+	}
+	return p.ErrorRate > 0 && b.requests >= p.MinRequests &&
+		float64(b.failures) >= p.ErrorRate*float64(b.requests)
+}
+
+func (b *breaker) eject() {
+	d := b.policy.EjectionTime
+	for i := 0; i < b.ejections && d > 0 && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if max := b.policy.MaxEjectionTime; max > 0 && d > max {
+		d = max
+	}
+	b.ejections++
+	b.ejectUntil = time.Now().Add(d)
+	b.reset()
+	b.setState(BreakerOpen)
+}
+
+func (b *breaker) reset() {
+	b.consecutive, b.requests, b.failures = 0, 0, 0
+	b.probes, b.successes = 0, 0
+	b.windowStart = time.Now()
+}
+
+func (b *breaker) isEjected() bool {
+	return atomic.LoadInt32(&b.ejected) != 0
+}
+
+func (b *breaker) halfOpenProbes() int {
+	if b.policy.HalfOpenProbes < 1 {
+		return 1
+	}
+	return b.policy.HalfOpenProbes
+}
+
+func (b *breaker) setState(state BreakerState) {
+	from := b.state
+	if from == state {
+		return
+	}
+	b.state = state
+	if state == BreakerClosed {
+		atomic.StoreInt32(&b.ejected, 0)
+	} else {
+		atomic.StoreInt32(&b.ejected, 1)
+	}
+	if b.notify != nil {
+		b.changes = append(b.changes, stateChange{from, state})
+	}
+}
+
+// unlock releases the lock and notifies the state changes made while
+// holding it, thus notify can call back into the breaker.
+func (b *breaker) unlock() {
+	changes := b.changes
+	b.changes = nil
+	b.mu.Unlock()
+	for _, c := range changes {
+		b.notify(c.from, c.to)
+	}
+}
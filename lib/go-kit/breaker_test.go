@@ -0,0 +1,129 @@
+package kit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/matryer/is"
+)
+
+func TestBreakerTrip(t *testing.T) {
+	is := is.New(t)
+
+	policy := &BreakerPolicy{ConsecutiveFailures: 3, EjectionTime: 20 * time.Millisecond}
+	b := newBreaker(policy, nil, nil)
+	for i := 0; i < 2; i++ {
+		b.record(io.EOF, false)
+	}
+	b.record(nil, false) // a success resets the consecutive failures
+	b.record(errors.New("exception"), false)
+	for i := 0; i < 2; i++ {
+		b.record(io.EOF, false)
+	}
+	is.Equal(b.State(), BreakerClosed)
+	b.record(io.EOF, false)
+	is.Equal(b.State(), BreakerOpen)
+	is.True(!b.available())
+	ok, _ := b.allow()
+	is.True(!ok)
+
+	// error rate within window
+	policy = &BreakerPolicy{ErrorRate: 0.5, MinRequests: 4, Window: time.Minute, EjectionTime: time.Minute}
+	b = newBreaker(policy, nil, nil)
+	for _, err := range []error{io.EOF, io.EOF, nil} {
+		b.record(err, false)
+	}
+	is.Equal(b.State(), BreakerClosed)
+	b.record(io.EOF, false)
+	is.Equal(b.State(), BreakerOpen)
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	is := is.New(t)
+
+	var changes []BreakerState
+	policy := &BreakerPolicy{ConsecutiveFailures: 1, EjectionTime: 20 * time.Millisecond, HalfOpenProbes: 2}
+	var b *breaker
+	b = newBreaker(policy, nil, func(from, to BreakerState) {
+		// called without holding the lock
+		is.Equal(b.State(), to)
+		changes = append(changes, to)
+	})
+	b.record(io.EOF, false)
+	time.Sleep(20 * time.Millisecond)
+	is.Equal(b.State(), BreakerHalfOpen)
+	is.True(b.available())
+
+	// the probes are limited
+	ok1, probe1 := b.allow()
+	ok2, probe2 := b.allow()
+	is.True(ok1 && probe1 && ok2 && probe2)
+	is.True(!b.available())
+	ok, _ := b.allow()
+	is.True(!ok)
+
+	// a failed probe ejects it again for a doubled time
+	b.record(nil, true)
+	b.record(io.EOF, true)
+	is.Equal(b.State(), BreakerOpen)
+	time.Sleep(20 * time.Millisecond)
+	is.Equal(b.State(), BreakerOpen)
+	time.Sleep(25 * time.Millisecond)
+	is.Equal(b.State(), BreakerHalfOpen)
+
+	for i := 0; i < 2; i++ {
+		ok, probe := b.allow()
+		is.True(ok && probe)
+		b.record(nil, probe)
+	}
+	is.Equal(b.State(), BreakerClosed)
+	is.Equal(changes, []BreakerState{BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen, BreakerClosed})
+}
+
+func TestBreakerEjectionTime(t *testing.T) {
+	is := is.New(t)
+
+	// the doubling ejection time never overflows
+	policy := &BreakerPolicy{EjectionTime: time.Hour}
+	b := newBreaker(policy, nil, nil)
+	b.ejections = 100
+	b.eject()
+	is.True(b.ejectUntil.After(time.Now().Add(time.Hour)))
+
+	policy.MaxEjectionTime = 2 * time.Hour
+	b.eject()
+	is.True(b.ejectUntil.Before(time.Now().Add(2*time.Hour + time.Second)))
+}
+
+func TestMaxEjectionPercent(t *testing.T) {
+	is := is.New(t)
+
+	failing := func(instance string) (endpoint.Endpoint, io.Closer, error) {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			return nil, io.EOF
+		}, nil, nil
+	}
+	policy := &BreakerPolicy{ConsecutiveFailures: 1, EjectionTime: time.Minute, MaxEjectionPercent: 50}
+	instancer := sd.FixedInstancer{"a:1", "b:1", "c:1", "d:1", "e:1"}
+	s := newNodeSet(instancer, failing, log.NewNopLogger(), policy)
+	defer s.Close()
+	for _, n := range s.All() {
+		n.call(context.Background(), nil)
+	}
+	nodes, err := s.Nodes()
+	is.NoErr(err)
+	is.Equal(len(nodes), 3) // 2 of 5 are ejected
+
+	// one instance can always be ejected
+	s = newNodeSet(sd.FixedInstancer{"a:1"}, failing, log.NewNopLogger(), policy)
+	defer s.Close()
+	s.All()[0].call(context.Background(), nil)
+	_, err = s.Nodes()
+	is.Equal(err, ErrCircuitOpen)
+}
@@ -14,10 +14,14 @@ import (
 	"github.com/rs/xid"
 )
 
+// DefaultMaxIdle is the default number of idle connections kept for
+// each instance.
+var DefaultMaxIdle = 8
+
 type Client struct {
 	caller   string
 	service  string
-	ifactory func(address string) (thrift.ProtocolInvoker, error)
+	dialer   thrift.Dialer
 	cfactory func(invoker thrift.Invoker) endpoint.Endpoint
 	opts     []thrift.Option
 	mws      []endpoint.Middleware
@@ -26,19 +30,27 @@ type Client struct {
 	instancer  sd.Instancer
 	endpointer sd.Endpointer
 	balancer   lb.Balancer
+	loadbal    LoadBalancer
+	breaker    *BreakerPolicy
 	logger     log.Logger
+
+	retrier    *retrier
+	idempotent map[string]bool
 }
 
 func NewClient(caller, service string, opts ...thrift.Option) *Client {
+	// Keep connections to each instance alive by default, which can
+	// be overridden by opts.
+	opts = append([]thrift.Option{thrift.WithMaxIdle(DefaultMaxIdle)}, opts...)
 	// Always use header transport for kit client.
 	opts = append(opts, thrift.WithHeader())
 	kc := &Client{
-		caller:   caller,
-		service:  service,
-		ifactory: thrift.NewProtocolInvokerFactory(thrift.StdDialer, opts...),
-		opts:     opts,
-		chain:    SetHeadersMiddleware,
-		logger:   DefaultLogger,
+		caller:  caller,
+		service: service,
+		dialer:  thrift.StdDialer,
+		opts:    opts,
+		chain:   SetHeadersMiddleware,
+		logger:  DefaultLogger,
 	}
 	return kc
 }
@@ -48,18 +60,63 @@ func (kc *Client) UseFactory(factory func(invoker thrift.Invoker) endpoint.Endpo
 	return kc
 }
 
+// UseDialer sets the dialer to connect instances, it must be called
+// before the address or instancer is set.
+func (kc *Client) UseDialer(dialer thrift.Dialer) *Client {
+	kc.dialer = dialer
+	return kc
+}
+
 func (kc *Client) UseAddress(addr string) *Client {
 	instancer := sd.FixedInstancer{addr}
 	return kc.UseInstancer(instancer)
 }
 
 func (kc *Client) UseInstancer(instancer sd.Instancer) *Client {
+	if kc.loadbal != nil {
+		nodes := newNodeSet(instancer, kc.endpointFactory, kc.logger, kc.breaker)
+		kc.resetDiscovery(nil, instancer, nodes)
+		return kc
+	}
 	endpointer := sd.NewEndpointer(instancer, kc.endpointFactory, kc.logger)
 	balancer := lb.NewRoundRobin(endpointer)
 	kc.resetDiscovery(balancer, instancer, endpointer)
 	return kc
 }
 
+// UseLoadBalancer makes the client pick instances from the instancer
+// by b instead of round robin, e.g. NewWeightedRoundRobin, NewP2C.
+func (kc *Client) UseLoadBalancer(b LoadBalancer) *Client {
+	kc.loadbal = b
+	if instancer := kc.instancer; instancer != nil {
+		// rebind the instancer without stopping it
+		kc.instancer = nil
+		kc.UseInstancer(instancer)
+	}
+	return kc
+}
+
+// UseCircuitBreaker enables circuit breaker for each instance, the failing
+// instances are ejected and skipped by the load balancer until they are
+// recovered. It implies UseLoadBalancer(NewWeightedRoundRobin()) if no
+// load balancer is set.
+func (kc *Client) UseCircuitBreaker(policy BreakerPolicy) *Client {
+	kc.breaker = &policy
+	if kc.loadbal == nil {
+		return kc.UseLoadBalancer(NewWeightedRoundRobin())
+	}
+	return kc.UseLoadBalancer(kc.loadbal)
+}
+
+// Nodes returns the instances and their state, it returns nil unless
+// UseLoadBalancer or UseCircuitBreaker is used.
+func (kc *Client) Nodes() []*Node {
+	if ns, ok := kc.endpointer.(*nodeSet); ok {
+		return ns.All()
+	}
+	return nil
+}
+
 func (kc *Client) resetDiscovery(balancer lb.Balancer, instancer sd.Instancer, endpointer sd.Endpointer) {
 	type closable interface{ Close() }
 
@@ -84,6 +141,25 @@ func (kc *Client) UseBalancer(balancer lb.Balancer) *Client {
 	return kc
 }
 
+// UseRetryPolicy enables retrying calls to idempotent methods.
+func (kc *Client) UseRetryPolicy(policy RetryPolicy) *Client {
+	kc.retrier = newRetrier(policy)
+	return kc
+}
+
+// UseIdempotent marks methods as idempotent, which are safe to be retried.
+// It is called by the generated kit client for methods annotated by
+// "idempotent" in the IDL.
+func (kc *Client) UseIdempotent(methods ...string) *Client {
+	if kc.idempotent == nil {
+		kc.idempotent = make(map[string]bool, len(methods))
+	}
+	for _, m := range methods {
+		kc.idempotent[m] = true
+	}
+	return kc
+}
+
 func (kc *Client) UseLogger(logger log.Logger) *Client {
 	kc.logger = logger
 	return kc
@@ -99,30 +175,72 @@ func (kc *Client) UseMiddleware(mw endpoint.Middleware, more ...endpoint.Middlew
 	return kc
 }
 
+// UseMetrics records requests to m, each attempt of retrying is recorded
+// as a request.
+func (kc *Client) UseMetrics(m *Metrics) *Client {
+	return kc.UseMiddleware(NewClientMetricsMiddleware(m))
+}
+
+// UseTracer starts a client span for each request, and propagates it to
+// the server by headers.
+func (kc *Client) UseTracer(t *Tracer) *Client {
+	return kc.UseMiddleware(NewClientTracingMiddleware(t))
+}
+
 func (kc *Client) Call(method string, ctx context.Context, request interface{}) (interface{}, error) {
-	if kc.balancer == nil {
-		return nil, fmt.Errorf("address/instancer/balancer not set")
+	info := NewClientRpcCtx(ctx, kc.caller, kc.service, method)
+	if kc.retrier == nil || !kc.idempotent[method] {
+		return kc.attempt(info, request)
 	}
-	ep, err := kc.balancer.Endpoint()
-	if err != nil {
-		return nil, err
+	return kc.retrier.call(ctx, method, func(ctx context.Context) (interface{}, error) {
+		// each attempt has its own rpc info, they may run concurrently
+		info := *info
+		info.Context = ctx
+		return kc.attempt(&info, request)
+	})
+}
+
+func (kc *Client) attempt(ctx *clientRpcCtx, request interface{}) (interface{}, error) {
+	var ep endpoint.Endpoint
+	if ns, ok := kc.endpointer.(*nodeSet); ok {
+		nodes, err := ns.Nodes()
+		if err != nil && len(nodes) == 0 {
+			return nil, err
+		}
+		node, err := kc.loadbal.Pick(ctx, request, nodes)
+		if err != nil {
+			return nil, err
+		}
+		ep = node.call
+	} else {
+		if kc.balancer == nil {
+			return nil, fmt.Errorf("address/instancer/balancer not set")
+		}
+		var err error
+		if ep, err = kc.balancer.Endpoint(); err != nil {
+			return nil, err
+		}
 	}
-	ctx = NewClientRpcCtx(ctx, kc.caller, kc.service, method)
 	return ep(ctx, request)
 }
 
+// endpointFactory makes an endpoint backed by a connection pool of the
+// instance, the pool is closed when the instance is gone.
 func (kc *Client) endpointFactory(instance string) (endpoint.Endpoint, io.Closer, error) {
-	invoker, err := kc.ifactory(instance)
-	if err != nil {
-		return nil, nil, err
-	}
+	address, _ := ParseInstance(instance)
+	ipool := thrift.NewInvokerPool(kc.dialer, address, kc.opts...)
 	ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+		invoker, err := ipool.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer invoker.Close()
 		call := kc.cfactory(invoker)
 		info := getClientRpcInfo(ctx)
 		info.protocol = invoker.Protocol()
 		return kc.chain(call)(ctx, request)
 	}
-	return ep, invoker, err
+	return ep, ipool, nil
 }
 
 // SetHeadersMiddleware write context information from ClientRpcInfo to request.
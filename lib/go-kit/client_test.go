@@ -0,0 +1,120 @@
+package kit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jxskiss/thriftkit/lib/thrift"
+	"github.com/matryer/is"
+)
+
+type echoStruct struct {
+	S string
+}
+
+func (p *echoStruct) Read(r thrift.Reader) (err error) {
+	if _, err = r.ReadStructBegin(); err != nil {
+		return err
+	}
+	for {
+		_, fieldType, fieldId, err := r.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if fieldType == thrift.STOP {
+			break
+		}
+		if fieldId == 1 && fieldType == thrift.STRING {
+			if p.S, err = r.ReadString(); err != nil {
+				return err
+			}
+		} else if err = r.Skip(fieldType); err != nil {
+			return err
+		}
+	}
+	return r.ReadStructEnd()
+}
+
+func (p *echoStruct) Write(w thrift.Writer) (err error) {
+	if err = w.WriteStructBegin("echoStruct"); err != nil {
+		return err
+	}
+	if err = w.WriteFieldBegin("S", thrift.STRING, 1); err != nil {
+		return err
+	}
+	if err = w.WriteString(p.S); err != nil {
+		return err
+	}
+	if err = w.WriteFieldStop(); err != nil {
+		return err
+	}
+	return w.WriteStructEnd()
+}
+
+// funcProcessor replies a call with the string returned by handle, the
+// connection is closed after the reply unless keepalive is set.
+type funcProcessor struct {
+	handle    func(ctx context.Context, s string) string
+	keepalive bool
+}
+
+func (p funcProcessor) Process(ctx context.Context, r thrift.Reader, w thrift.Writer) error {
+	for {
+		name, _, seqid, err := r.ReadMessageBegin()
+		if err != nil {
+			return err
+		}
+		var args echoStruct
+		if err = args.Read(r); err != nil {
+			return err
+		}
+		ret := &echoStruct{S: p.handle(ctx, args.S)}
+		if err = w.WriteMessageBegin(name, thrift.REPLY, seqid); err != nil {
+			return err
+		}
+		if err = ret.Write(w); err != nil {
+			return err
+		}
+		if err = w.Flush(); err != nil || !p.keepalive {
+			return err
+		}
+	}
+}
+
+func startServer(t *testing.T, p thrift.Processor) (*thrift.Server, string) {
+	server := thrift.NewServer(p, thrift.WithHeader())
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.ServeListener(ln)
+	return server, ln.Addr().String()
+}
+
+func echoFactory(invoker thrift.Invoker) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		ret := &echoStruct{}
+		err := invoker.Invoke(ctx, "Echo", request, ret)
+		return ret, err
+	}
+}
+
+func TestClientRetryHeaders(t *testing.T) {
+	is := is.New(t)
+
+	// the connection is closed after each call, the kept alive one is
+	// replaced by a new connection when it is reused
+	server, addr := startServer(t, funcProcessor{handle: func(ctx context.Context, s string) string {
+		return thrift.IncomingHeaders(ctx)[CALLER]
+	}})
+	defer server.Stop()
+
+	cli := NewClient("caller", "echo").UseFactory(echoFactory).UseAddress(addr)
+	for i := 0; i < 3; i++ {
+		rsp, err := cli.Call("Echo", context.Background(), &echoStruct{})
+		is.NoErr(err)
+		is.Equal(rsp.(*echoStruct).S, "caller")
+	}
+}
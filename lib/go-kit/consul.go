@@ -1,10 +1,14 @@
 package kit
 
 import (
+	"context"
 	"errors"
+	"net"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/sd"
 	"github.com/go-kit/kit/sd/consul"
 	stdconsul "github.com/hashicorp/consul/api"
@@ -34,11 +38,154 @@ func SimpleConsulRegistrar(id, name, addr string) (sd.Registrar, error) {
 	return registrar, nil
 }
 
+// SimpleConsulInstancer returns an instancer of the passing instances of
+// service from the local Consul agent, see ConsulRegistry.Instancer.
 func SimpleConsulInstancer(service string) (sd.Instancer, error) {
 	client, err := stdconsul.NewClient(stdconsul.DefaultConfig())
 	if err != nil {
 		return nil, err
 	}
-	instancer := consul.NewInstancer(consul.NewClient(client), DefaultLogger, service, nil, true)
-	return instancer, nil
+	return NewConsulRegistry(client).Instancer(service, DefaultLogger), nil
+}
+
+// ConsulRegistry registers instances to the local Consul agent.
+type ConsulRegistry struct {
+	client *stdconsul.Client
+}
+
+func NewConsulRegistry(client *stdconsul.Client) *ConsulRegistry {
+	return &ConsulRegistry{client: client}
+}
+
+func (c *ConsulRegistry) Register(r *Registration) error {
+	host, portStr, err := net.SplitHostPort(r.Address)
+	if err != nil {
+		return errors.New("invalid service address")
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return errors.New("invalid port number")
+	}
+	meta := make(map[string]string, len(r.Metadata)+1)
+	for k, v := range r.Metadata {
+		meta[k] = v
+	}
+	meta["weight"] = strconv.Itoa(r.Weight)
+	registration := &stdconsul.AgentServiceRegistration{
+		ID:      r.ID,
+		Name:    r.Name,
+		Tags:    r.Tags,
+		Address: host,
+		Port:    port,
+		Meta:    meta,
+		Weights: &stdconsul.AgentWeights{Passing: r.Weight, Warning: 1},
+	}
+	check := r.Check
+	deregister := ""
+	if check.DeregisterAfter > 0 {
+		deregister = check.DeregisterAfter.String()
+	}
+	if check.TCP {
+		registration.Checks = append(registration.Checks, &stdconsul.AgentServiceCheck{
+			CheckID:  r.ID + ":tcp",
+			TCP:      r.Address,
+			Interval: durationString(check.Interval, 10*time.Second),
+			Timeout:  durationString(check.Timeout, time.Second),
+
+			DeregisterCriticalServiceAfter: deregister,
+		})
+	}
+	if check.TTL > 0 {
+		registration.Checks = append(registration.Checks, &stdconsul.AgentServiceCheck{
+			CheckID: r.ID + ":ttl",
+			TTL:     check.TTL.String(),
+
+			DeregisterCriticalServiceAfter: deregister,
+		})
+	}
+	return c.client.Agent().ServiceRegister(registration)
+}
+
+func (c *ConsulRegistry) Deregister(r *Registration) error {
+	return c.client.Agent().ServiceDeregister(r.ID)
+}
+
+func (c *ConsulRegistry) Heartbeat(r *Registration, err error) error {
+	status, output := stdconsul.HealthPassing, ""
+	if err != nil {
+		status, output = stdconsul.HealthCritical, err.Error()
+	}
+	return c.client.Agent().UpdateTTL(r.ID+":ttl", output, status)
+}
+
+// Instancer returns an instancer of the passing instances of service.
+// Unlike the instancer of go-kit, which reports plain addresses, the
+// instances carry the weight and metadata registered by Register.
+func (c *ConsulRegistry) Instancer(service string, logger log.Logger) sd.Instancer {
+	ic := newInstanceCache()
+	ctx, cancel := context.WithCancel(context.Background())
+	ic.stop = cancel
+	// apply the initial state before return, like consul.NewInstancer
+	instances, index, err := c.instances(ctx, service, 0)
+	if err != nil {
+		logger.Log("service", service, "err", err)
+	}
+	ic.Update(sd.Event{Instances: instances, Err: err})
+	go c.watch(ctx, ic, service, index, logger)
+	return ic
+}
+
+func (c *ConsulRegistry) watch(ctx context.Context, ic *instanceCache, service string, index uint64, logger log.Logger) {
+	retry := time.Second
+	for ctx.Err() == nil {
+		instances, newIndex, err := c.instances(ctx, service, index)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Log("service", service, "err", err)
+			ic.Update(sd.Event{Err: err})
+			select {
+			case <-ctx.Done():
+			case <-time.After(retry):
+			}
+			continue
+		}
+		index = newIndex
+		ic.Update(sd.Event{Instances: instances})
+	}
+}
+
+// instances blocks until the index of service changes from index.
+func (c *ConsulRegistry) instances(ctx context.Context, service string, index uint64) ([]string, uint64, error) {
+	opts := (&stdconsul.QueryOptions{WaitIndex: index}).WithContext(ctx)
+	entries, meta, err := c.client.Health().Service(service, "", true, opts)
+	if err != nil {
+		return nil, index, err
+	}
+	if meta.LastIndex < index {
+		// the index is reset, e.g. by restarting Consul
+		meta.LastIndex = 0
+	}
+	instances := make([]string, 0, len(entries))
+	for _, e := range entries {
+		host := e.Service.Address
+		if host == "" {
+			host = e.Node.Address
+		}
+		reg := Registration{
+			Address:  net.JoinHostPort(host, strconv.Itoa(e.Service.Port)),
+			Weight:   e.Service.Weights.Passing,
+			Metadata: e.Service.Meta,
+		}
+		instances = append(instances, reg.Instance())
+	}
+	return instances, meta.LastIndex, nil
+}
+
+func durationString(d, default_ time.Duration) string {
+	if d <= 0 {
+		d = default_
+	}
+	return d.String()
 }
@@ -0,0 +1,192 @@
+package kit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRefreshInterval is used by NewFileInstancer and NewDNSInstancer
+// if the interval is not positive.
+var DefaultRefreshInterval = 10 * time.Second
+
+// FileInstance is an entry of the instances file, see NewFileInstancer.
+type FileInstance struct {
+	Address  string            `yaml:"address" json:"address"`
+	Weight   int               `yaml:"weight" json:"weight"`
+	Metadata map[string]string `yaml:"metadata" json:"metadata"`
+}
+
+// NewFileInstancer returns an instancer of service from a YAML or JSON
+// file, which maps service names to their instances, e.g.
+//
+//	search:
+//	  - address: 127.0.0.1:9090
+//	    weight: 2
+//	    metadata: {zone: a}
+//	  - address: unix:///tmp/search.sock
+//
+// The file is checked every interval and reloaded if it is changed.
+func NewFileInstancer(path, service string, interval time.Duration, logger log.Logger) (sd.Instancer, error) {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	w := &fileWatcher{path: path, service: service}
+	instances, err := w.load()
+	if err != nil {
+		return nil, err
+	}
+	c := newInstanceCache()
+	c.Update(sd.Event{Instances: instances})
+	quit := make(chan struct{})
+	c.stop = func() { close(quit) }
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+			}
+			if !w.changed() {
+				continue
+			}
+			instances, err := w.load()
+			if err != nil {
+				logger.Log("path", path, "err", err)
+			}
+			c.Update(sd.Event{Instances: instances, Err: err})
+		}
+	}()
+	return c, nil
+}
+
+type fileWatcher struct {
+	path    string
+	service string
+	modTime time.Time
+	size    int64
+}
+
+func (w *fileWatcher) changed() bool {
+	fi, err := os.Stat(w.path)
+	if err != nil {
+		return false
+	}
+	return !fi.ModTime().Equal(w.modTime) || fi.Size() != w.size
+}
+
+func (w *fileWatcher) load() ([]string, error) {
+	fi, err := os.Stat(w.path)
+	if err != nil {
+		return nil, err
+	}
+	w.modTime, w.size = fi.ModTime(), fi.Size()
+	data, err := ioutil.ReadFile(w.path)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON
+	var services map[string][]FileInstance
+	if err = yaml.Unmarshal(data, &services); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", w.path, err)
+	}
+	instances := make([]string, 0, len(services[w.service]))
+	for _, x := range services[w.service] {
+		reg := Registration{Address: x.Address, Weight: x.Weight, Metadata: x.Metadata}
+		instances = append(instances, reg.Instance())
+	}
+	return instances, nil
+}
+
+// NewDNSInstancer returns an instancer which resolves name every interval.
+//
+// If name is in the form of "_service._proto.domain", it is resolved as
+// SRV records, the weights of records are used as the weights of the
+// instances. Otherwise name must be "host:port", host is resolved as
+// A/AAAA records, e.g. a headless service of Kubernetes.
+func NewDNSInstancer(name string, interval time.Duration, logger log.Logger) sd.Instancer {
+	return newDNSInstancer(&dnsResolver{name: name, resolver: net.DefaultResolver}, interval, logger)
+}
+
+func newDNSInstancer(r *dnsResolver, interval time.Duration, logger log.Logger) sd.Instancer {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	name := r.name
+	c := newInstanceCache()
+	quit := make(chan struct{})
+	c.stop = func() { close(quit) }
+	resolve := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		defer cancel()
+		instances, err := r.resolve(ctx)
+		if err != nil {
+			logger.Log("name", name, "err", err)
+		}
+		c.Update(sd.Event{Instances: instances, Err: err})
+	}
+	resolve()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+				resolve()
+			}
+		}
+	}()
+	return c
+}
+
+type dnsResolver struct {
+	name     string
+	resolver interface {
+		LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+		LookupHost(ctx context.Context, host string) ([]string, error)
+	}
+}
+
+func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
+	if strings.HasPrefix(r.name, "_") {
+		_, records, err := r.resolver.LookupSRV(ctx, "", "", r.name)
+		if err != nil {
+			return nil, err
+		}
+		instances := make([]string, 0, len(records))
+		for _, srv := range records {
+			reg := Registration{
+				Address: net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))),
+				Weight:  int(srv.Weight),
+			}
+			instances = append(instances, reg.Instance())
+		}
+		return instances, nil
+	}
+	host, port, err := net.SplitHostPort(r.name)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := r.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]string, len(addrs))
+	for i, addr := range addrs {
+		instances[i] = net.JoinHostPort(addr, port)
+	}
+	return instances, nil
+}
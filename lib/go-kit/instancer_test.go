@@ -0,0 +1,135 @@
+package kit
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/matryer/is"
+)
+
+func TestFileInstancer(t *testing.T) {
+	is := is.New(t)
+
+	dir, err := ioutil.TempDir("", "instancer")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "instances.yaml")
+	is.NoErr(ioutil.WriteFile(path, []byte(`
+search:
+  - address: 127.0.0.1:9090
+    weight: 2
+    metadata: {zone: a}
+  - address: unix:///tmp/search.sock
+other:
+  - address: 127.0.0.1:9091
+`), 0644))
+
+	_, err = NewFileInstancer(filepath.Join(dir, "missing.yaml"), "search", time.Millisecond, log.NewNopLogger())
+	is.True(err != nil)
+
+	instancer, err := NewFileInstancer(path, "search", 5*time.Millisecond, log.NewNopLogger())
+	is.NoErr(err)
+	defer instancer.Stop()
+	ch := make(chan sd.Event, 10)
+	instancer.Register(ch)
+	is.Equal(nextEvent(t, ch).Instances, []string{"127.0.0.1:9090?weight=2&zone=a", "unix:///tmp/search.sock"})
+
+	// reloaded when it is changed, JSON is also accepted
+	is.NoErr(ioutil.WriteFile(path, []byte(`{"search": [{"address": "127.0.0.1:9092"}]}`), 0644))
+	is.Equal(nextEvent(t, ch).Instances, []string{"127.0.0.1:9092"})
+
+	// the instances are kept on error
+	is.NoErr(ioutil.WriteFile(path, []byte(`search: [`), 0644))
+	event := nextEvent(t, ch)
+	is.True(event.Err != nil)
+	is.Equal(event.Instances, []string{"127.0.0.1:9092"})
+
+	// the default interval is used
+	is.NoErr(ioutil.WriteFile(path, []byte(`other: [{address: "127.0.0.1:9091"}]`), 0644))
+	instancer, err = NewFileInstancer(path, "other", 0, log.NewNopLogger())
+	is.NoErr(err)
+	instancer.Stop()
+}
+
+type fakeResolver struct {
+	mu    sync.Mutex
+	srv   []*net.SRV
+	hosts []string
+	err   error
+}
+
+func (r *fakeResolver) set(hosts []string, err error) {
+	r.mu.Lock()
+	r.hosts, r.err = hosts, err
+	r.mu.Unlock()
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return name, r.srv, r.err
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.hosts, r.err
+}
+
+func TestDNSResolver(t *testing.T) {
+	is := is.New(t)
+
+	resolver := &fakeResolver{
+		srv: []*net.SRV{
+			{Target: "a.search.local.", Port: 9090, Weight: 3},
+			{Target: "b.search.local.", Port: 9090, Weight: 1},
+		},
+		hosts: []string{"10.0.0.1", "fd00::1"},
+	}
+	r := &dnsResolver{name: "_thrift._tcp.search.local", resolver: resolver}
+	instances, err := r.resolve(context.Background())
+	is.NoErr(err)
+	is.Equal(instances, []string{"a.search.local:9090?weight=3", "b.search.local:9090"})
+
+	r = &dnsResolver{name: "search.local:9090", resolver: resolver}
+	instances, err = r.resolve(context.Background())
+	is.NoErr(err)
+	is.Equal(instances, []string{"10.0.0.1:9090", "[fd00::1]:9090"})
+
+	r = &dnsResolver{name: "search.local", resolver: resolver}
+	_, err = r.resolve(context.Background())
+	is.True(err != nil) // missing port
+}
+
+func TestDNSInstancer(t *testing.T) {
+	is := is.New(t)
+
+	resolver := &fakeResolver{hosts: []string{"10.0.0.1"}}
+	r := &dnsResolver{name: "search.local:9090", resolver: resolver}
+	instancer := newDNSInstancer(r, 5*time.Millisecond, log.NewNopLogger())
+	defer instancer.Stop()
+	ch := make(chan sd.Event, 100) // errors are sent every interval
+	instancer.Register(ch)
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.1:9090"})
+
+	resolver.set([]string{"10.0.0.2", "10.0.0.1"}, nil)
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.1:9090", "10.0.0.2:9090"})
+
+	// the instances are kept on error
+	resolver.set(nil, errors.New("timeout"))
+	event := nextEvent(t, ch)
+	is.True(event.Err != nil)
+	is.Equal(len(event.Instances), 2)
+
+	// the default interval is used
+	newDNSInstancer(r, 0, log.NewNopLogger()).Stop()
+}
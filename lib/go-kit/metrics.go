@@ -0,0 +1,302 @@
+package kit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+)
+
+// Metrics records requests of kit server or client. The metrics are
+// labeled by "service", "method", "caller" and "env", Errors is also
+// labeled by "error", which is the type name of the error, e.g. the
+// exception type declared in IDL.
+//
+// Any backend implementing go-kit's metrics interfaces can be used,
+// e.g. Prometheus or expvar, or NewTextMetrics for local scraping.
+type Metrics struct {
+	Requests metrics.Counter
+	Errors   metrics.Counter
+	Latency  metrics.Histogram // in seconds
+}
+
+func (m *Metrics) observe(service, method, caller, env string, begin time.Time, err error) {
+	lvs := []string{"service", service, "method", method, "caller", caller, "env", env}
+	if m.Requests != nil {
+		m.Requests.With(lvs...).Add(1)
+	}
+	if m.Latency != nil {
+		m.Latency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}
+	if err != nil && m.Errors != nil {
+		m.Errors.With(append(lvs, "error", errorType(err))...).Add(1)
+	}
+}
+
+func errorType(err error) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", err), "*")
+}
+
+// NewServerMetricsMiddleware records requests of kit server, it must be
+// chained after ContextMiddleware.
+func NewServerMetricsMiddleware(m *Metrics) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func(begin time.Time) {
+				r := getServerRpcInfo(ctx)
+				m.observe(r.Service, r.Method, r.Caller, r.Env, begin, err)
+			}(time.Now())
+			return next(ctx, request)
+		}
+	}
+}
+
+// NewClientMetricsMiddleware records requests of kit client, each attempt
+// of retrying is recorded as a request.
+func NewClientMetricsMiddleware(m *Metrics) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func(begin time.Time) {
+				r := getClientRpcInfo(ctx)
+				m.observe(r.Service, r.Method, r.Caller, r.Env, begin, err)
+			}(time.Now())
+			return next(ctx, request)
+		}
+	}
+}
+
+// DefaultBuckets are the upper bounds of latency histogram buckets
+// in seconds.
+var DefaultBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// NewTextMetrics returns Metrics backed by r, the metrics are named by
+// prefix, e.g. "thrift_server" gives "thrift_server_requests_total",
+// "thrift_server_errors_total" and "thrift_server_latency_seconds".
+func NewTextMetrics(r *TextRegistry, prefix string) *Metrics {
+	return &Metrics{
+		Requests: r.NewCounter(prefix+"_requests_total", "Number of requests."),
+		Errors:   r.NewCounter(prefix+"_errors_total", "Number of failed requests."),
+		Latency:  r.NewHistogram(prefix+"_latency_seconds", "Latency of requests.", DefaultBuckets),
+	}
+}
+
+// DefaultRegistry is a registry shared by the process, it can be served
+// by an HTTP server, e.g. http.Handle("/metrics", kit.DefaultRegistry).
+var DefaultRegistry = NewTextRegistry()
+
+// TextRegistry is an in-memory metrics backend which exposes the metrics
+// in Prometheus text format, it is intended for local scraping.
+type TextRegistry struct {
+	mu     sync.Mutex
+	names  []string
+	family map[string]*textFamily
+}
+
+func NewTextRegistry() *TextRegistry {
+	return &TextRegistry{family: make(map[string]*textFamily)}
+}
+
+type textFamily struct {
+	name    string
+	help    string
+	typ     string
+	buckets []float64 // histogram only
+
+	mu     sync.Mutex
+	series map[string]*textSeries
+}
+
+type textSeries struct {
+	labels  string // formatted as {k="v",...}
+	value   float64
+	sum     float64
+	counts  []uint64 // histogram only, not cumulative
+	samples uint64
+}
+
+func (r *TextRegistry) get(name, help, typ string, buckets []float64) *textFamily {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if f, ok := r.family[name]; ok {
+		return f
+	}
+	f := &textFamily{
+		name:    name,
+		help:    help,
+		typ:     typ,
+		buckets: buckets,
+		series:  make(map[string]*textSeries),
+	}
+	r.family[name] = f
+	r.names = append(r.names, name)
+	return f
+}
+
+// NewCounter returns a counter registered by name, counters of a same
+// name share the values.
+func (r *TextRegistry) NewCounter(name, help string) metrics.Counter {
+	return &textCounter{f: r.get(name, help, "counter", nil)}
+}
+
+// NewHistogram returns a histogram registered by name, buckets are the
+// upper bounds of buckets in increasing order.
+func (r *TextRegistry) NewHistogram(name, help string, buckets []float64) metrics.Histogram {
+	return &textHistogram{f: r.get(name, help, "histogram", buckets)}
+}
+
+// ServeHTTP implements http.Handler.
+func (r *TextRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	r.WriteTo(w)
+}
+
+// WriteTo writes all metrics to w in Prometheus text format.
+func (r *TextRegistry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	families := make([]*textFamily, len(r.names))
+	for i, name := range r.names {
+		families[i] = r.family[name]
+	}
+	r.mu.Unlock()
+
+	cw := &countWriter{w: bufio.NewWriter(w)}
+	for _, f := range families {
+		f.writeTo(cw)
+	}
+	if err := cw.w.Flush(); err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return cw.n, cw.err
+}
+
+type countWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countWriter) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(w.w, format, args...)
+	w.n += int64(n)
+	w.err = err
+}
+
+func (f *textFamily) writeTo(w *countWriter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.printf("# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.typ)
+	keys := make([]string, 0, len(f.series))
+	for k := range f.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := f.series[k]
+		if f.typ == "counter" {
+			w.printf("%s%s %s\n", f.name, s.labels, formatFloat(s.value))
+			continue
+		}
+		var cumulative uint64
+		for i, le := range f.buckets {
+			cumulative += s.counts[i]
+			w.printf("%s_bucket%s %d\n", f.name, withLabel(s.labels, "le", formatFloat(le)), cumulative)
+		}
+		w.printf("%s_bucket%s %d\n", f.name, withLabel(s.labels, "le", "+Inf"), s.samples)
+		w.printf("%s_sum%s %s\n", f.name, s.labels, formatFloat(s.sum))
+		w.printf("%s_count%s %d\n", f.name, s.labels, s.samples)
+	}
+}
+
+func (f *textFamily) getSeries(labelValues []string) *textSeries {
+	labels := formatLabels(labelValues)
+	s, ok := f.series[labels]
+	if !ok {
+		s = &textSeries{labels: labels}
+		if f.typ == "histogram" {
+			s.counts = make([]uint64, len(f.buckets))
+		}
+		f.series[labels] = s
+	}
+	return s
+}
+
+func formatLabels(labelValues []string) string {
+	if len(labelValues) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i+1 < len(labelValues); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(labelValues[i])
+		b.WriteString("=")
+		b.WriteString(strconv.Quote(labelValues[i+1]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func withLabel(labels, key, value string) string {
+	pair := key + "=" + strconv.Quote(value)
+	if labels == "" {
+		return "{" + pair + "}"
+	}
+	return labels[:len(labels)-1] + "," + pair + "}"
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type textCounter struct {
+	f   *textFamily
+	lvs []string
+}
+
+func (c *textCounter) With(labelValues ...string) metrics.Counter {
+	return &textCounter{f: c.f, lvs: append(append([]string(nil), c.lvs...), labelValues...)}
+}
+
+func (c *textCounter) Add(delta float64) {
+	c.f.mu.Lock()
+	c.f.getSeries(c.lvs).value += delta
+	c.f.mu.Unlock()
+}
+
+type textHistogram struct {
+	f   *textFamily
+	lvs []string
+}
+
+func (h *textHistogram) With(labelValues ...string) metrics.Histogram {
+	return &textHistogram{f: h.f, lvs: append(append([]string(nil), h.lvs...), labelValues...)}
+}
+
+func (h *textHistogram) Observe(value float64) {
+	h.f.mu.Lock()
+	s := h.f.getSeries(h.lvs)
+	if i := sort.SearchFloat64s(h.f.buckets, value); i < len(s.counts) {
+		s.counts[i]++
+	}
+	s.sum += value
+	s.samples++
+	h.f.mu.Unlock()
+}
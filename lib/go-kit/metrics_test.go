@@ -0,0 +1,99 @@
+package kit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/matryer/is"
+)
+
+func TestTextRegistry(t *testing.T) {
+	is := is.New(t)
+
+	r := NewTextRegistry()
+	c := r.NewCounter("requests_total", "Number of requests.")
+	c.With("method", "Get").Add(1)
+	c.With("method", "Get").Add(2)
+	c.With("method", `Say"Hi"`).Add(1)
+	h := r.NewHistogram("latency_seconds", "Latency of requests.", []float64{0.1, 1})
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(5)
+	// a counter of the same name shares the values
+	r.NewCounter("requests_total", "").With("method", "Get").Add(1)
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	is.NoErr(err)
+	is.Equal(int(n), buf.Len())
+	is.Equal(buf.String(), `# HELP requests_total Number of requests.
+# TYPE requests_total counter
+requests_total{method="Get"} 4
+requests_total{method="Say\"Hi\""} 1
+# HELP latency_seconds Latency of requests.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 2
+latency_seconds_bucket{le="+Inf"} 3
+latency_seconds_sum 5.55
+latency_seconds_count 3
+`)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	is.Equal(rec.Body.String(), buf.String())
+	is.True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain"))
+}
+
+func TestServerMetrics(t *testing.T) {
+	is := is.New(t)
+
+	r := NewTextRegistry()
+	m := &Metrics{Requests: r.NewCounter("requests_total", ""), Errors: r.NewCounter("errors_total", "")}
+	ep := ServerMW(func(ctx context.Context, request interface{}) (interface{}, error) {
+		if request != nil {
+			return nil, errors.New("failed")
+		}
+		return "ok", nil
+	}, log.NewNopLogger(), NewServerMetricsMiddleware(m))
+	ctx := NewServerRpcCtx(context.Background(), "echo", "Get")
+	ep(ctx, nil)
+	ep(ctx, 1)
+
+	var buf bytes.Buffer
+	r.WriteTo(&buf)
+	lines := strings.Split(buf.String(), "\n")
+	is.Equal(lines[2], `requests_total{service="echo",method="Get",caller="",env=""} 2`)
+	is.Equal(lines[5], `errors_total{service="echo",method="Get",caller="",env="",error="errors.errorString"} 1`)
+}
+
+func TestClientMetrics(t *testing.T) {
+	is := is.New(t)
+
+	server, addr := startServer(t, funcProcessor{handle: func(ctx context.Context, s string) string {
+		return s
+	}, keepalive: true})
+	defer server.Stop()
+
+	r := NewTextRegistry()
+	cli := NewClient("caller", "echo").UseFactory(echoFactory).UseAddress(addr).
+		UseMetrics(NewTextMetrics(r, "thrift_client"))
+	for i := 0; i < 3; i++ {
+		_, err := cli.Call("Echo", context.Background(), &echoStruct{})
+		is.NoErr(err)
+	}
+
+	var buf bytes.Buffer
+	r.WriteTo(&buf)
+	labels := fmt.Sprintf(`{service="echo",method="Echo",caller="caller",env=%q}`, os.Getenv("ENV"))
+	is.True(strings.Contains(buf.String(), "thrift_client_requests_total"+labels+" 3"))
+	is.True(strings.Contains(buf.String(), "thrift_client_latency_seconds_count"+labels+" 3"))
+	is.True(!strings.Contains(buf.String(), `thrift_client_errors_total{`))
+}
@@ -0,0 +1,376 @@
+package kit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+// Registration describes an instance to be registered to service discovery.
+type Registration struct {
+	ID       string
+	Name     string
+	Address  string // host:port
+	Tags     []string
+	Metadata map[string]string // e.g. version, env
+	Weight   int               // defaults to 1
+	Check    HealthCheck
+}
+
+// Instance returns the instance string reported to the clients, which
+// carries the metadata and weight, see ParseInstance.
+func (r *Registration) Instance() string {
+	meta := make(map[string]string, len(r.Metadata)+1)
+	for k, v := range r.Metadata {
+		meta[k] = v
+	}
+	if r.Weight > 1 {
+		meta["weight"] = strconv.Itoa(r.Weight)
+	}
+	return FormatInstance(r.Address, meta)
+}
+
+// HealthCheck configures how the registry checks an instance.
+type HealthCheck struct {
+	// TCP makes the registry connect to the address every Interval,
+	// failing if it takes longer than Timeout.
+	TCP      bool
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// TTL makes the registrar send heartbeats every TTL/2, the instance
+	// becomes unhealthy if no heartbeat is received within TTL. If Ping
+	// is not nil, its result is reported with the heartbeat, e.g. the
+	// function returned by ThriftPing.
+	TTL  time.Duration
+	Ping func(ctx context.Context) error
+
+	// DeregisterAfter makes the registry remove an instance which has
+	// been unhealthy for the time, zero means never.
+	DeregisterAfter time.Duration
+}
+
+// Registry is a backend of service discovery, e.g. Consul.
+type Registry interface {
+	Register(r *Registration) error
+	Deregister(r *Registration) error
+
+	// Heartbeat reports the health of an instance with TTL check,
+	// err is nil if the instance is healthy.
+	Heartbeat(r *Registration, err error) error
+}
+
+// Registrar registers an instance to a registry and sends heartbeats for
+// the TTL check, it implements sd.Registrar.
+type Registrar struct {
+	registry Registry
+	reg      Registration
+	logger   log.Logger
+
+	mu   sync.Mutex
+	quit chan struct{}
+	done chan struct{}
+}
+
+func NewRegistrar(registry Registry, reg Registration, logger log.Logger) *Registrar {
+	if reg.Weight < 1 {
+		reg.Weight = 1
+	}
+	return &Registrar{
+		registry: registry,
+		reg:      reg,
+		logger:   log.With(logger, "service", reg.Name, "id", reg.ID),
+	}
+}
+
+// Register implements sd.Registrar.
+func (r *Registrar) Register() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.registry.Register(&r.reg); err != nil {
+		r.logger.Log("action", "register", "err", err)
+		return
+	}
+	r.logger.Log("action", "register")
+	if r.reg.Check.TTL > 0 && r.quit == nil {
+		r.quit, r.done = make(chan struct{}), make(chan struct{})
+		go r.heartbeat(r.quit, r.done)
+	}
+}
+
+// Deregister implements sd.Registrar.
+func (r *Registrar) Deregister() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.quit != nil {
+		close(r.quit)
+		<-r.done
+		r.quit, r.done = nil, nil
+	}
+	if err := r.registry.Deregister(&r.reg); err != nil {
+		r.logger.Log("action", "deregister", "err", err)
+		return
+	}
+	r.logger.Log("action", "deregister")
+}
+
+// Attach registers the instance, and makes the server deregister it on
+// Shutdown, before the server stops accepting new connections.
+func (r *Registrar) Attach(server *thrift.Server) {
+	r.Register()
+	server.RegisterOnShutdown(r.Deregister)
+}
+
+func (r *Registrar) heartbeat(quit, done chan struct{}) {
+	defer close(done)
+	check := r.reg.Check
+	ticker := time.NewTicker(check.TTL / 2)
+	defer ticker.Stop()
+	for {
+		var err error
+		if check.Ping != nil {
+			timeout := check.Timeout
+			if timeout <= 0 {
+				timeout = check.TTL / 2
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			err = check.Ping(ctx)
+			cancel()
+		}
+		if e := r.registry.Heartbeat(&r.reg, err); e != nil {
+			r.logger.Log("action", "heartbeat", "err", e)
+		}
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ThriftPing returns a function which checks the server at address by
+// calling method with empty arguments, the server is healthy if it
+// replies, including replying an exception. The method should be cheap
+// and have no required arguments. The connection is kept to be reused by
+// the checks, closer closes it after the instance is deregistered.
+func ThriftPing(address, method string, opts ...thrift.Option) (ping func(ctx context.Context) error, closer io.Closer) {
+	opts = append([]thrift.Option{thrift.WithMaxIdle(1)}, opts...)
+	cli := thrift.NewClient(thrift.StdDialer, address, opts...)
+	ping = func(ctx context.Context) error {
+		err := cli.Invoke(ctx, method, emptyStruct{}, &emptyStruct{})
+		if _, ok := err.(*thrift.ApplicationException); ok {
+			return nil
+		}
+		return err
+	}
+	return ping, cli
+}
+
+// emptyStruct writes an empty struct and skips anything when reading.
+type emptyStruct struct{}
+
+func (emptyStruct) Read(r thrift.Reader) error {
+	return r.Skip(thrift.STRUCT)
+}
+
+func (emptyStruct) Write(w thrift.Writer) error {
+	if err := w.WriteStructBegin("empty"); err != nil {
+		return err
+	}
+	if err := w.WriteFieldStop(); err != nil {
+		return err
+	}
+	return w.WriteStructEnd()
+}
+
+var ErrNotRegistered = errors.New("kit: instance not registered")
+
+// MemoryRegistry keeps registrations in memory, it is intended for testing
+// and running without Consul. TCP checks are not performed, an instance
+// with TTL check is healthy until it misses heartbeats or reports errors.
+type MemoryRegistry struct {
+	mu        sync.Mutex
+	entries   map[string]map[string]*memoryEntry // name -> id -> entry
+	instancer map[string]*instanceCache
+}
+
+type memoryEntry struct {
+	reg     Registration
+	healthy bool
+	expire  *time.Timer
+}
+
+func NewMemoryRegistry() *MemoryRegistry {
+	return &MemoryRegistry{
+		entries:   make(map[string]map[string]*memoryEntry),
+		instancer: make(map[string]*instanceCache),
+	}
+}
+
+func (m *MemoryRegistry) Register(r *Registration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := m.entries[r.Name]
+	if entries == nil {
+		entries = make(map[string]*memoryEntry)
+		m.entries[r.Name] = entries
+	}
+	if old := entries[r.ID]; old != nil && old.expire != nil {
+		old.expire.Stop()
+	}
+	e := &memoryEntry{reg: *r, healthy: true}
+	entries[r.ID] = e
+	m.resetExpire(e)
+	m.notify(r.Name)
+	return nil
+}
+
+func (m *MemoryRegistry) Deregister(r *Registration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.entries[r.Name][r.ID]
+	if e == nil {
+		return ErrNotRegistered
+	}
+	if e.expire != nil {
+		e.expire.Stop()
+	}
+	delete(m.entries[r.Name], r.ID)
+	m.notify(r.Name)
+	return nil
+}
+
+func (m *MemoryRegistry) Heartbeat(r *Registration, err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.entries[r.Name][r.ID]
+	if e == nil {
+		return ErrNotRegistered
+	}
+	m.resetExpire(e)
+	if healthy := err == nil; healthy != e.healthy {
+		e.healthy = healthy
+		m.notify(r.Name)
+	}
+	return nil
+}
+
+func (m *MemoryRegistry) resetExpire(e *memoryEntry) {
+	ttl := e.reg.Check.TTL
+	if ttl <= 0 {
+		return
+	}
+	if e.expire != nil {
+		e.expire.Stop()
+	}
+	e.expire = time.AfterFunc(ttl, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.entries[e.reg.Name][e.reg.ID] == e && e.healthy {
+			e.healthy = false
+			m.notify(e.reg.Name)
+		}
+	})
+}
+
+// Instancer returns an instancer of the healthy instances of service.
+func (m *MemoryRegistry) Instancer(service string) sd.Instancer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := m.instancer[service]
+	if c == nil {
+		c = newInstanceCache()
+		c.Update(sd.Event{Instances: m.instances(service)})
+		m.instancer[service] = c
+	}
+	return c
+}
+
+func (m *MemoryRegistry) notify(service string) {
+	if c := m.instancer[service]; c != nil {
+		c.Update(sd.Event{Instances: m.instances(service)})
+	}
+}
+
+func (m *MemoryRegistry) instances(service string) []string {
+	instances := []string{}
+	for _, e := range m.entries[service] {
+		if e.healthy {
+			instances = append(instances, e.reg.Instance())
+		}
+	}
+	return instances
+}
+
+// instanceCache implements sd.Instancer by broadcasting the latest state
+// to the registered channels.
+type instanceCache struct {
+	mu    sync.Mutex
+	state sd.Event
+	chans map[chan<- sd.Event]struct{}
+	stop  func()
+}
+
+func newInstanceCache() *instanceCache {
+	return &instanceCache{chans: make(map[chan<- sd.Event]struct{})}
+}
+
+// Update broadcasts event if it differs from the current state.
+func (c *instanceCache) Update(event sd.Event) {
+	instances := append([]string(nil), event.Instances...)
+	sort.Strings(instances)
+	event.Instances = instances
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if event.Err == nil && c.state.Err == nil && equalStrings(c.state.Instances, instances) {
+		return
+	}
+	if event.Err != nil {
+		// keep the instances, assuming they are still good
+		event.Instances = c.state.Instances
+	}
+	c.state = event
+	for ch := range c.chans {
+		ch <- event
+	}
+}
+
+func (c *instanceCache) Register(ch chan<- sd.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.chans[ch] = struct{}{}
+	ch <- c.state
+}
+
+func (c *instanceCache) Deregister(ch chan<- sd.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.chans, ch)
+}
+
+func (c *instanceCache) Stop() {
+	if c.stop != nil {
+		c.stop()
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+package kit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	stdconsul "github.com/hashicorp/consul/api"
+	"github.com/matryer/is"
+)
+
+func nextEvent(t *testing.T, ch chan sd.Event) sd.Event {
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event")
+	}
+	return sd.Event{}
+}
+
+func TestMemoryRegistry(t *testing.T) {
+	is := is.New(t)
+
+	m := NewMemoryRegistry()
+	a := &Registration{ID: "a", Name: "search", Address: "10.0.0.1:80", Weight: 2}
+	b := &Registration{ID: "b", Name: "search", Address: "10.0.0.2:80", Metadata: map[string]string{"zone": "z"}}
+	is.NoErr(m.Register(a))
+
+	ch := make(chan sd.Event, 10)
+	instancer := m.Instancer("search")
+	instancer.Register(ch)
+	defer instancer.Deregister(ch)
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.1:80?weight=2"})
+	is.NoErr(m.Register(b))
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.1:80?weight=2", "10.0.0.2:80?zone=z"})
+
+	// unhealthy instances are removed
+	is.NoErr(m.Heartbeat(a, errors.New("down")))
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.2:80?zone=z"})
+	is.NoErr(m.Heartbeat(a, nil))
+	is.Equal(len(nextEvent(t, ch).Instances), 2)
+
+	is.NoErr(m.Deregister(a))
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.2:80?zone=z"})
+	is.Equal(m.Deregister(a), ErrNotRegistered)
+	is.Equal(m.Heartbeat(a, nil), ErrNotRegistered)
+	is.Equal(len(ch), 0)
+
+	// missing heartbeats
+	c := &Registration{ID: "c", Name: "search", Address: "10.0.0.3:80", Check: HealthCheck{TTL: 20 * time.Millisecond}}
+	is.NoErr(m.Register(c))
+	is.Equal(len(nextEvent(t, ch).Instances), 2)
+	is.Equal(len(nextEvent(t, ch).Instances), 1)
+}
+
+func TestRegistrarHeartbeat(t *testing.T) {
+	is := is.New(t)
+
+	m := NewMemoryRegistry()
+	var failing, pings int32
+	r := NewRegistrar(m, Registration{
+		ID:      "a",
+		Name:    "search",
+		Address: "10.0.0.1:80",
+		Check: HealthCheck{TTL: 40 * time.Millisecond, Ping: func(ctx context.Context) error {
+			atomic.AddInt32(&pings, 1)
+			if atomic.LoadInt32(&failing) != 0 {
+				return errors.New("down")
+			}
+			return nil
+		}},
+	}, log.NewNopLogger())
+	ch := make(chan sd.Event, 10)
+	m.Instancer("search").Register(ch)
+	is.Equal(len(nextEvent(t, ch).Instances), 0)
+
+	r.Register()
+	is.Equal(nextEvent(t, ch).Instances, []string{"10.0.0.1:80"})
+	time.Sleep(100 * time.Millisecond) // the heartbeats keep it healthy
+	is.Equal(len(ch), 0)
+	is.True(atomic.LoadInt32(&pings) >= 3)
+
+	atomic.StoreInt32(&failing, 1)
+	is.Equal(len(nextEvent(t, ch).Instances), 0)
+	atomic.StoreInt32(&failing, 0)
+	is.Equal(len(nextEvent(t, ch).Instances), 1)
+
+	r.Deregister()
+	is.Equal(len(nextEvent(t, ch).Instances), 0)
+	n := atomic.LoadInt32(&pings)
+	time.Sleep(50 * time.Millisecond)
+	is.Equal(atomic.LoadInt32(&pings), n) // the heartbeat is stopped
+}
+
+func TestThriftPing(t *testing.T) {
+	is := is.New(t)
+
+	server, addr := startServer(t, funcProcessor{handle: func(ctx context.Context, s string) string {
+		return s
+	}, keepalive: true})
+	defer server.Stop()
+	ping, closer := ThriftPing(addr, "Ping")
+	is.NoErr(ping(context.Background()))
+	is.NoErr(ping(context.Background()))
+	is.NoErr(closer.Close())
+
+	// nothing listens on the address after the listener is closed
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	is.NoErr(err)
+	ln.Close()
+	ping, closer = ThriftPing(ln.Addr().String(), "Ping")
+	defer closer.Close()
+	is.True(ping(context.Background()) != nil)
+}
+
+func TestConsulInstancer(t *testing.T) {
+	is := is.New(t)
+
+	entries := func(weight int) []*stdconsul.ServiceEntry {
+		return []*stdconsul.ServiceEntry{{
+			Node: &stdconsul.Node{Address: "10.0.0.1"},
+			Service: &stdconsul.AgentService{
+				Port:    80,
+				Meta:    map[string]string{"zone": "z"},
+				Weights: stdconsul.AgentWeights{Passing: weight},
+			},
+		}}
+	}
+	consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/health/service/search" || r.URL.Query().Get("passing") == "" {
+			http.NotFound(w, r)
+			return
+		}
+		// the first query returns at once, the blocking query returns
+		// a new weight, and then blocks until it is cancelled
+		switch r.URL.Query().Get("index") {
+		case "":
+			w.Header().Set("X-Consul-Index", "1")
+			json.NewEncoder(w).Encode(entries(1))
+		case "1":
+			w.Header().Set("X-Consul-Index", "2")
+			json.NewEncoder(w).Encode(entries(3))
+		default:
+			<-r.Context().Done()
+		}
+	}))
+	defer consul.Close()
+
+	client, err := stdconsul.NewClient(&stdconsul.Config{Address: strings.TrimPrefix(consul.URL, "http://")})
+	is.NoErr(err)
+	instancer := NewConsulRegistry(client).Instancer("search", log.NewNopLogger())
+	defer instancer.Stop()
+	ch := make(chan sd.Event, 10)
+	instancer.Register(ch)
+	event := nextEvent(t, ch)
+	if len(event.Instances) == 1 && event.Instances[0] == "10.0.0.1:80?zone=z" {
+		event = nextEvent(t, ch)
+	}
+	is.NoErr(event.Err)
+	is.Equal(event.Instances, []string{"10.0.0.1:80?weight=3&zone=z"})
+	address, meta := ParseInstance(event.Instances[0])
+	is.Equal(address, "10.0.0.1:80")
+	is.Equal(meta["weight"], "3")
+}
@@ -0,0 +1,262 @@
+package kit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+)
+
+// RetryPolicy controls retrying of calls to idempotent methods, which are
+// annotated by "idempotent" in the IDL. Other methods are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of attempts of a call, including the
+	// first one and hedged requests.
+	MaxAttempts int
+
+	// Backoff between attempts is doubled after each attempt from
+	// BaseBackoff and limited by MaxBackoff, the real waiting time
+	// is randomly chosen from [backoff/2, backoff).
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+
+	// BudgetRatio limits the retries to the ratio of calls, e.g. 0.1
+	// permits 10 percent more requests being sent due to retrying.
+	// BudgetBurst is the number of retries permitted before any calls
+	// accumulate the budget. Zero BudgetRatio means no limit.
+	BudgetRatio float64
+	BudgetBurst int
+
+	// Retryable reports whether a failed attempt should be retried,
+	// DefaultRetryable is used if it is nil.
+	Retryable func(err error) bool
+
+	// HedgeQuantile enables hedged requests if it is greater than zero.
+	// A backup request is sent if no response is received after the
+	// quantile of observed latency of the method, e.g. 0.95.
+	HedgeQuantile float64
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseBackoff: 10 * time.Millisecond,
+	MaxBackoff:  200 * time.Millisecond,
+	BudgetRatio: 0.1,
+	BudgetBurst: 10,
+}
+
+// DefaultRetryable retries errors of connection and transport, the
+// exceptions returned by server are not retried.
+func DefaultRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	for _, target := range retryableErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+var retryableErrors = []error{
+	thrift.ErrPeerClosed, thrift.ErrConnClosed, thrift.ErrTooManyConn,
+	io.EOF, io.ErrUnexpectedEOF, ErrCircuitOpen,
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p.BaseBackoff <= 0 {
+		return 0
+	}
+	d := p.BaseBackoff << uint(attempt-1)
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+type retrier struct {
+	policy RetryPolicy
+
+	mu      sync.Mutex
+	tokens  float64
+	methods sync.Map // method -> *latencyTracker
+}
+
+func newRetrier(policy RetryPolicy) *retrier {
+	if policy.Retryable == nil {
+		policy.Retryable = DefaultRetryable
+	}
+	return &retrier{policy: policy, tokens: float64(policy.BudgetBurst)}
+}
+
+func (r *retrier) deposit() {
+	if r.policy.BudgetRatio <= 0 {
+		return
+	}
+	max := float64(r.policy.BudgetBurst)
+	if max < 1 {
+		max = 1
+	}
+	r.mu.Lock()
+	if r.tokens += r.policy.BudgetRatio; r.tokens > max {
+		r.tokens = max
+	}
+	r.mu.Unlock()
+}
+
+func (r *retrier) withdraw() bool {
+	if r.policy.BudgetRatio <= 0 {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
+
+func (r *retrier) tracker(method string) *latencyTracker {
+	if x, ok := r.methods.Load(method); ok {
+		return x.(*latencyTracker)
+	}
+	x, _ := r.methods.LoadOrStore(method, &latencyTracker{})
+	return x.(*latencyTracker)
+}
+
+type attemptFunc func(ctx context.Context) (interface{}, error)
+
+func (r *retrier) call(ctx context.Context, method string, attempt attemptFunc) (response interface{}, err error) {
+	r.deposit()
+	tracker := r.tracker(method)
+	for i := 1; ; i++ {
+		var n int
+		response, n, err = r.hedge(ctx, tracker, attempt, r.policy.MaxAttempts-i+1)
+		i += n - 1
+		if err == nil || i >= r.policy.MaxAttempts || !r.policy.Retryable(err) ||
+			ctx.Err() != nil || !r.withdraw() {
+			return response, err
+		}
+		timer := time.NewTimer(r.policy.backoff(i))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+	}
+}
+
+type attemptResult struct {
+	response interface{}
+	err      error
+}
+
+// hedge sends a request, and a backup one if the first is slower than the
+// quantile of latency. It returns the first successful response, or the
+// last error, and the number of requests sent. The request still in flight
+// is cancelled when hedge returns.
+func (r *retrier) hedge(ctx context.Context, tracker *latencyTracker, attempt attemptFunc, maxAttempts int) (interface{}, int, error) {
+	begin := time.Now()
+	delay := tracker.quantile(r.policy.HedgeQuantile)
+	if r.policy.HedgeQuantile <= 0 || delay <= 0 || maxAttempts < 2 {
+		response, err := attempt(ctx)
+		if err == nil {
+			tracker.observe(time.Since(begin))
+		}
+		return response, 1, err
+	}
+
+	results := make(chan attemptResult, 2)
+	var cancels []context.CancelFunc
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+	run := func() {
+		ctx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		go func() {
+			response, err := attempt(ctx)
+			results <- attemptResult{response, err}
+		}()
+	}
+	run()
+	sent, pending := 1, 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	var result attemptResult
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if r.withdraw() {
+				run()
+				sent++
+				pending++
+			}
+		case result = <-results:
+			pending--
+			if result.err == nil {
+				tracker.observe(time.Since(begin))
+				return result.response, sent, nil
+			}
+		}
+	}
+	return nil, sent, result.err
+}
+
+// latencyTracker keeps recent latency samples to estimate quantiles.
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples [256]time.Duration
+	count   int
+	sorted  []time.Duration
+}
+
+func (t *latencyTracker) observe(d time.Duration) {
+	t.mu.Lock()
+	t.samples[t.count%len(t.samples)] = d
+	t.count++
+	if t.count%32 == 0 {
+		t.sorted = nil
+	}
+	t.mu.Unlock()
+}
+
+// quantile returns zero until enough samples are collected.
+func (t *latencyTracker) quantile(q float64) time.Duration {
+	if q <= 0 {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.count < 32 {
+		return 0
+	}
+	if t.sorted == nil {
+		n := t.count
+		if n > len(t.samples) {
+			n = len(t.samples)
+		}
+		t.sorted = append([]time.Duration(nil), t.samples[:n]...)
+		sort.Slice(t.sorted, func(i, j int) bool { return t.sorted[i] < t.sorted[j] })
+	}
+	i := int(q * float64(len(t.sorted)))
+	if i >= len(t.sorted) {
+		i = len(t.sorted) - 1
+	}
+	return t.sorted[i]
+}
@@ -0,0 +1,99 @@
+package kit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jxskiss/thriftkit/lib/thrift"
+	"github.com/matryer/is"
+)
+
+func TestDefaultRetryable(t *testing.T) {
+	is := is.New(t)
+
+	is.True(DefaultRetryable(io.EOF))
+	is.True(DefaultRetryable(fmt.Errorf("read reply: %w", io.EOF)))
+	is.True(DefaultRetryable(thrift.ErrPeerClosed))
+	is.True(DefaultRetryable(&net.OpError{Op: "dial", Err: errors.New("refused")}))
+	is.True(!DefaultRetryable(context.Canceled))
+	is.True(!DefaultRetryable(fmt.Errorf("call: %w", context.DeadlineExceeded)))
+	is.True(!DefaultRetryable(errors.New("exception")))
+}
+
+func TestRetryBudget(t *testing.T) {
+	is := is.New(t)
+
+	r := newRetrier(RetryPolicy{MaxAttempts: 3, BudgetRatio: 0.5, BudgetBurst: 1})
+	var attempts int
+	failing := func(ctx context.Context) (interface{}, error) {
+		attempts++
+		return nil, io.EOF
+	}
+	// each call deposits 0.5 token, a retry withdraws 1 token
+	for _, want := range []int{2, 1, 2, 1} {
+		attempts = 0
+		_, err := r.call(context.Background(), "Get", failing)
+		is.Equal(err, io.EOF)
+		is.Equal(attempts, want)
+	}
+
+	// not retryable
+	attempts = 0
+	r = newRetrier(RetryPolicy{MaxAttempts: 3})
+	_, err := r.call(context.Background(), "Get", func(ctx context.Context) (interface{}, error) {
+		attempts++
+		return nil, errors.New("exception")
+	})
+	is.True(err != nil)
+	is.Equal(attempts, 1)
+
+	// succeed after retrying
+	attempts = 0
+	rsp, err := r.call(context.Background(), "Get", func(ctx context.Context) (interface{}, error) {
+		if attempts++; attempts < 3 {
+			return nil, io.EOF
+		}
+		return "ok", nil
+	})
+	is.NoErr(err)
+	is.Equal(rsp, "ok")
+	is.Equal(attempts, 3)
+}
+
+func TestHedge(t *testing.T) {
+	is := is.New(t)
+
+	r := newRetrier(RetryPolicy{MaxAttempts: 2, HedgeQuantile: 0.9})
+	tracker := r.tracker("Get")
+	for i := 0; i < 32; i++ {
+		tracker.observe(10 * time.Millisecond)
+	}
+
+	var attempts int32
+	cancelled := make(chan struct{})
+	begin := time.Now()
+	rsp, err := r.call(context.Background(), "Get", func(ctx context.Context) (interface{}, error) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			// the first attempt is stuck until it is cancelled
+			<-ctx.Done()
+			close(cancelled)
+			return nil, ctx.Err()
+		}
+		return "backup", nil
+	})
+	is.NoErr(err)
+	is.Equal(rsp, "backup")
+	is.True(time.Since(begin) >= 10*time.Millisecond)
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the losing attempt is not cancelled")
+	}
+	is.Equal(atomic.LoadInt32(&attempts), int32(2))
+}
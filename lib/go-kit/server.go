@@ -24,24 +24,28 @@ const (
 )
 
 func MW(next endpoint.Endpoint, logger log.Logger) endpoint.Endpoint {
-	return endpoint.Chain(
-		ContextMiddleware,
-		mkLoggingMiddleware(logger),
-		mkRecoverMiddleware(logger),
-	)(next)
+	return ServerMW(next, logger)
+}
+
+// ServerMW is like MW, mws are chained after ContextMiddleware, e.g. the
+// middlewares for metrics and tracing.
+func ServerMW(next endpoint.Endpoint, logger log.Logger, mws ...endpoint.Middleware) endpoint.Endpoint {
+	chain := make([]endpoint.Middleware, 0, len(mws)+2)
+	chain = append(chain, mws...)
+	chain = append(chain, mkLoggingMiddleware(logger), mkRecoverMiddleware(logger))
+	return endpoint.Chain(ContextMiddleware, chain...)(next)
 }
 
 // ContextMiddleware populate header information from request into ServerRpcInfo context.
 // Generally, this should be the first middleware of any endpoint.
 func ContextMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		p := thrift.ProtocolFromCtx(ctx)
 		// service, method is already filled when creating the ServerRpcInfo
 		info := getServerRpcInfo(ctx)
 		info.RemoteAddr = thrift.RemoteAddrFromCtx(ctx)
 
 		// read request headers
-		info.headers = p.ReadHeaders()
+		info.headers = thrift.IncomingHeaders(ctx)
 		if info.LogID = info.headers[LOGID]; info.LogID == "" {
 			info.LogID = xid.New().String()
 		}
@@ -0,0 +1,282 @@
+package kit
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+// Headers of W3C Trace Context, see https://www.w3.org/TR/trace-context/.
+const (
+	TRACEPARENT = "traceparent"
+	TRACESTATE  = "tracestate"
+)
+
+var ErrInvalidTraceparent = errors.New("kit: invalid traceparent")
+
+type (
+	TraceID [16]byte
+	SpanID  [8]byte
+)
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+func (id SpanID) String() string  { return hex.EncodeToString(id[:]) }
+
+func (id TraceID) IsValid() bool { return id != TraceID{} }
+func (id SpanID) IsValid() bool  { return id != SpanID{} }
+
+// SpanContext is the part of a span propagated across process boundary.
+type SpanContext struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	Sampled    bool
+	TraceState string
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// Traceparent formats sc as the value of traceparent header.
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-" + flags
+}
+
+// ParseTraceparent parses the value of traceparent header.
+func ParseTraceparent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" ||
+		(parts[0] == "00" && len(parts) != 4) ||
+		len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, ErrInvalidTraceparent
+	}
+	var flags [1]byte
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return sc, ErrInvalidTraceparent
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return sc, ErrInvalidTraceparent
+	}
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return sc, ErrInvalidTraceparent
+	}
+	if !sc.IsValid() {
+		return sc, ErrInvalidTraceparent
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = iota
+	SpanKindServer
+	SpanKindClient
+)
+
+func (k SpanKind) String() string {
+	switch k {
+	case SpanKindServer:
+		return "server"
+	case SpanKindClient:
+		return "client"
+	}
+	return "internal"
+}
+
+// Span records a call, its fields must not be changed after End.
+type Span struct {
+	Name       string
+	Kind       SpanKind
+	Context    SpanContext
+	Parent     SpanID // zero for root span
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	Err        error
+
+	tracer *Tracer
+}
+
+// SetAttribute sets an attribute of span, it is not safe to be called
+// concurrently.
+func (s *Span) SetAttribute(key, value string) {
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]string)
+	}
+	s.Attributes[key] = value
+}
+
+// Finish ends the span with err, and exports it if it is sampled.
+func (s *Span) Finish(err error) {
+	s.End, s.Err = time.Now(), err
+	if s.Context.Sampled && s.tracer != nil && s.tracer.exporter != nil {
+		s.tracer.exporter.ExportSpans(context.Background(), []*Span{s})
+	}
+}
+
+// SpanExporter exports finished spans to a tracing backend, it mirrors
+// the SpanExporter of OpenTelemetry SDK so that an adapter is simple.
+type SpanExporter interface {
+	ExportSpans(ctx context.Context, spans []*Span) error
+	Shutdown(ctx context.Context) error
+}
+
+// Tracer creates spans and exports them when they finish.
+type Tracer struct {
+	exporter   SpanExporter
+	sampleRate float64
+}
+
+// NewTracer returns a tracer which samples sampleRate of root spans,
+// the child spans follow the decision of their parents.
+func NewTracer(exporter SpanExporter, sampleRate float64) *Tracer {
+	return &Tracer{exporter: exporter, sampleRate: sampleRate}
+}
+
+// StartSpan starts a span as a child of parent, or a root span if parent
+// is invalid.
+func (t *Tracer) StartSpan(name string, kind SpanKind, parent SpanContext) *Span {
+	span := &Span{
+		Name:   name,
+		Kind:   kind,
+		Start:  time.Now(),
+		tracer: t,
+	}
+	if parent.IsValid() {
+		span.Context = parent
+		span.Parent = parent.SpanID
+	} else {
+		randomID(span.Context.TraceID[:])
+		span.Context.Sampled = t.sampleRate >= 1 || rand.Float64() < t.sampleRate
+	}
+	randomID(span.Context.SpanID[:])
+	return span
+}
+
+// randomID fills id with random bytes from crypto/rand, which is unique
+// across processes unlike the unseeded math/rand.
+func randomID(id []byte) {
+	for {
+		if _, err := crand.Read(id); err != nil {
+			// fall back to math/rand, an ID is still needed
+			rand.Read(id)
+		}
+		for _, b := range id {
+			if b != 0 {
+				return
+			}
+		}
+	}
+}
+
+type spanCtxKey struct{}
+
+// ContextWithSpan returns a new context carrying span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanCtxKey{}, span)
+}
+
+// SpanFromContext returns the current span, or nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanCtxKey{}).(*Span)
+	return span
+}
+
+// NewServerTracingMiddleware starts a server span for each request, as a
+// child of the span propagated by the traceparent header if present.
+// It must be chained after ContextMiddleware.
+func NewServerTracingMiddleware(t *Tracer) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			info := getServerRpcInfo(ctx)
+			parent, _ := ParseTraceparent(info.headers[TRACEPARENT])
+			if parent.IsValid() {
+				parent.TraceState = info.headers[TRACESTATE]
+			}
+			span := t.StartSpan(info.Service+"/"+info.Method, SpanKindServer, parent)
+			span.SetAttribute("rpc.system", "thrift")
+			span.SetAttribute("rpc.service", info.Service)
+			span.SetAttribute("rpc.method", info.Method)
+			span.SetAttribute("caller", info.Caller)
+			span.SetAttribute("net.peer.addr", info.RemoteAddr)
+			defer func() { span.Finish(err) }()
+			return next(ContextWithSpan(ctx, span), request)
+		}
+	}
+}
+
+// NewClientTracingMiddleware starts a client span for each request, as a
+// child of the span in context, and propagates it by the traceparent and
+// tracestate headers.
+func NewClientTracingMiddleware(t *Tracer) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			info := getClientRpcInfo(ctx)
+			var parent SpanContext
+			if x := SpanFromContext(ctx); x != nil {
+				parent = x.Context
+			}
+			span := t.StartSpan(info.Service+"/"+info.Method, SpanKindClient, parent)
+			span.SetAttribute("rpc.system", "thrift")
+			span.SetAttribute("rpc.service", info.Service)
+			span.SetAttribute("rpc.method", info.Method)
+			span.SetAttribute("caller", info.Caller)
+			info.protocol.SetHeader(TRACEPARENT, span.Context.Traceparent())
+			if span.Context.TraceState != "" {
+				info.protocol.SetHeader(TRACESTATE, span.Context.TraceState)
+			}
+			defer func() { span.Finish(err) }()
+			return next(ctx, request)
+		}
+	}
+}
+
+// InMemoryExporter keeps the exported spans in memory, it is intended
+// for testing.
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+func (e *InMemoryExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	e.mu.Lock()
+	e.spans = append(e.spans, spans...)
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *InMemoryExporter) Shutdown(ctx context.Context) error {
+	e.Reset()
+	return nil
+}
+
+// Spans returns the exported spans in order.
+func (e *InMemoryExporter) Spans() []*Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]*Span(nil), e.spans...)
+}
+
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	e.spans = nil
+	e.mu.Unlock()
+}
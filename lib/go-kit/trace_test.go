@@ -0,0 +1,104 @@
+package kit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/matryer/is"
+)
+
+func TestTraceparent(t *testing.T) {
+	is := is.New(t)
+
+	s := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseTraceparent(s)
+	is.NoErr(err)
+	is.Equal(sc.TraceID.String(), "4bf92f3577b34da6a3ce929d0e0e4736")
+	is.Equal(sc.SpanID.String(), "00f067aa0ba902b7")
+	is.True(sc.Sampled)
+	is.Equal(sc.Traceparent(), s)
+
+	// future versions may have more fields
+	_, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-extra")
+	is.NoErr(err)
+	for _, s := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01",
+	} {
+		_, err = ParseTraceparent(s)
+		is.Equal(err, ErrInvalidTraceparent)
+	}
+}
+
+func TestStartSpan(t *testing.T) {
+	is := is.New(t)
+
+	exporter := NewInMemoryExporter()
+	tracer := NewTracer(exporter, 1)
+	root := tracer.StartSpan("root", SpanKindInternal, SpanContext{})
+	is.True(root.Context.IsValid())
+	is.True(root.Context.Sampled)
+	is.True(!root.Parent.IsValid())
+	child := tracer.StartSpan("child", SpanKindInternal, root.Context)
+	is.Equal(child.Context.TraceID, root.Context.TraceID)
+	is.Equal(child.Parent, root.Context.SpanID)
+	is.True(child.Context.SpanID != root.Context.SpanID)
+	child.Finish(nil)
+	root.Finish(nil)
+	spans := exporter.Spans()
+	is.Equal(len(spans), 2)
+	is.Equal(spans[0], child)
+
+	// the IDs are unique
+	seen := make(map[TraceID]bool)
+	for i := 0; i < 1000; i++ {
+		id := tracer.StartSpan("root", SpanKindInternal, SpanContext{}).Context.TraceID
+		is.True(!seen[id])
+		seen[id] = true
+	}
+
+	// not sampled
+	exporter.Reset()
+	tracer = NewTracer(exporter, 0)
+	root = tracer.StartSpan("root", SpanKindInternal, SpanContext{})
+	tracer.StartSpan("child", SpanKindInternal, root.Context).Finish(nil)
+	root.Finish(nil)
+	is.Equal(len(exporter.Spans()), 0)
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	is := is.New(t)
+
+	exporter := NewInMemoryExporter()
+	tracer := NewTracer(exporter, 1)
+	server, addr := startServer(t, funcProcessor{handle: func(ctx context.Context, s string) string {
+		ep := ServerMW(func(ctx context.Context, request interface{}) (interface{}, error) {
+			return SpanFromContext(ctx).Context.TraceID.String(), nil
+		}, log.NewNopLogger(), NewServerTracingMiddleware(tracer))
+		rsp, _ := ep(NewServerRpcCtx(ctx, "echo", "Echo"), s)
+		return rsp.(string)
+	}, keepalive: true})
+	defer server.Stop()
+
+	cli := NewClient("caller", "echo").UseFactory(echoFactory).UseAddress(addr).UseTracer(tracer)
+	root := tracer.StartSpan("root", SpanKindInternal, SpanContext{})
+	rsp, err := cli.Call("Echo", ContextWithSpan(context.Background(), root), &echoStruct{})
+	is.NoErr(err)
+	is.Equal(rsp.(*echoStruct).S, root.Context.TraceID.String())
+
+	spans := exporter.Spans()
+	is.Equal(len(spans), 2)
+	serverSpan, clientSpan := spans[0], spans[1]
+	is.Equal(serverSpan.Kind, SpanKindServer)
+	is.Equal(serverSpan.Name, "echo/Echo")
+	is.Equal(serverSpan.Attributes["caller"], "caller")
+	is.Equal(clientSpan.Kind, SpanKindClient)
+	is.Equal(clientSpan.Parent, root.Context.SpanID)
+	is.Equal(serverSpan.Parent, clientSpan.Context.SpanID)
+	is.Equal(serverSpan.Context.TraceID, root.Context.TraceID)
+}
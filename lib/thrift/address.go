@@ -0,0 +1,49 @@
+package thrift
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// parseAddress splits an address into network and address parts for
+// net.Dial and net.Listen. The following forms are understood:
+//
+//	host:port, tcp://host:port        TCP
+//	unix:///path/to/socket            Unix domain socket
+//	unix://@name                      abstract Unix domain socket (Linux)
+//	fd://3                            inherited file descriptor (listen only)
+//
+// Any other "network://address" form is passed to the net package as is.
+func parseAddress(addr string) (network, address string) {
+	i := strings.Index(addr, "://")
+	if i < 0 {
+		return "tcp", addr
+	}
+	return addr[:i], addr[i+3:]
+}
+
+// peerKey returns the canonical form of addr, to be used as key of peers
+// in connection pool, so that different forms of a same address share
+// one peer.
+func peerKey(addr string) string {
+	network, address := parseAddress(addr)
+	return network + "://" + address
+}
+
+// listen listens on addr, which may be any form understood by parseAddress.
+func listen(addr string) (net.Listener, error) {
+	network, address := parseAddress(addr)
+	if network != "fd" {
+		return net.Listen(network, address)
+	}
+	fd, err := strconv.ParseUint(address, 10, 0)
+	if err != nil {
+		return nil, fmt.Errorf("thrift: invalid file descriptor %q", address)
+	}
+	f := os.NewFile(uintptr(fd), "fd"+address)
+	defer f.Close() // net.FileListener dups the descriptor
+	return net.FileListener(f)
+}
@@ -21,11 +21,15 @@ func (r *binaryReader) ReadMessageBegin() (name string, typeId MessageType, seqi
 	if protoID, err = r.prot.preReadMessageBegin(); err != nil {
 		return
 	}
-	// the protocol may be changed during preReadMessageBegin
+	// the protocol may be changed during preReadMessageBegin, the transport
+	// header has already been consumed, so don't call ReadMessageBegin again
 	if protoID != ProtocolIDBinary {
-		return r.prot.ReadMessageBegin()
+		return (*compactReader)(r).readMessageBegin()
 	}
+	return r.readMessageBegin()
+}
 
+func (r *binaryReader) readMessageBegin() (name string, typeId MessageType, seqid int32, err error) {
 	var n int32
 	if n, err = r.ReadI32(); err != nil {
 		return
@@ -349,6 +353,11 @@ func (w *binaryWriter) WriteBinary(value []byte) error {
 	return err
 }
 
+func (w *binaryWriter) WriteRaw(raw []byte) error {
+	_, err := w.Write(raw)
+	return err
+}
+
 func (w *binaryWriter) Flush() error {
 	if err := w.Writer.Flush(); err != nil {
 		return err
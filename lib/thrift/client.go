@@ -52,10 +52,7 @@ func (cli *client) Invoke(ctx context.Context, method string, arg, ret interface
 	}
 	defer cli.cpool.Put(conn)
 
-	opts := cli.opts
-	for _, opt := range options {
-		opts = opt(opts)
-	}
+	opts := applyCallOptions(ctx, cli.opts, options)
 	_ = conn.SetReadTimeout(opts.rTimeout)  // shall not fail
 	_ = conn.SetWriteTimeout(opts.wTimeout) // shall not fail
 	if ctx.Done() != nil {
@@ -79,10 +76,10 @@ func (cli *client) Invoke(ctx context.Context, method string, arg, ret interface
 
 	reqctx := context.WithValue(ctx, clientConnCtxKey{}, conn)
 	reqctx = context.WithValue(reqctx, clientProtocolCtxKey{}, prot)
-	err = invoke(reqctx, method, arg, ret)
+	err = invoke(reqctx, method, arg, ret, opts)
 	if err != nil && err == ErrPeerClosed && conn.IsReused() {
 		// retry on reused & peer closed connection
-		return cli.Invoke(ctx, method, arg, ret)
+		return cli.Invoke(ctx, method, arg, ret, options...)
 	}
 	return err
 }
@@ -91,9 +88,10 @@ func (cli *client) Close() error {
 	return cli.cpool.Close()
 }
 
-func invoke(ctx context.Context, method string, arg, ret interface{}, options ...CallOption) (err error) {
+func invoke(ctx context.Context, method string, arg, ret interface{}, opts options) (err error) {
 	conn := ctx.Value(clientConnCtxKey{}).(Conn)
 	prot := ctx.Value(clientProtocolCtxKey{}).(*Protocol)
+	setRequestHeaders(ctx, prot, opts.forwardHeaders)
 
 	seqid := conn.NextSequence()
 	_ = prot.WriteMessageBegin(method, CALL, seqid) // shall never fail
@@ -125,6 +123,11 @@ func invoke(ctx context.Context, method string, arg, ret interface{}, options ..
 	if rseq != seqid {
 		return ErrSeqMismatch
 	}
+	if opts.responseHeaders != nil {
+		for k, v := range prot.ReadHeaders() {
+			opts.responseHeaders[k] = v
+		}
+	}
 	if rt == EXCEPTION {
 		var exc ApplicationException
 		if err = exc.Read(prot); err == nil {
@@ -145,6 +148,10 @@ func invoke(ctx context.Context, method string, arg, ret interface{}, options ..
 }
 
 func NewProtocolInvokerFactory(dialer Dialer, opts ...Option) func(address string) (ProtocolInvoker, error) {
+	return newFactory(dialer, opts...).New
+}
+
+func newFactory(dialer Dialer, opts ...Option) *factory {
 	factory := &factory{
 		opts: DefaultOptions,
 	}
@@ -155,7 +162,38 @@ func NewProtocolInvokerFactory(dialer Dialer, opts ...Option) func(address strin
 	factory.ppool.New = func() interface{} {
 		return NewProtocol(nil, factory.opts)
 	}
-	return factory.New
+	return factory
+}
+
+// InvokerPool gives out ProtocolInvokers to a single address, each one
+// holds a connection taken from a pool and a Protocol of its own, which are
+// given back when the invoker is closed.
+type InvokerPool struct {
+	address string
+	f       *factory
+}
+
+func NewInvokerPool(dialer Dialer, address string, opts ...Option) *InvokerPool {
+	return &InvokerPool{address: address, f: newFactory(dialer, opts...)}
+}
+
+// Get returns an invoker which must be closed after use, it waits for
+// an available connection until ctx is done.
+func (p *InvokerPool) Get(ctx context.Context) (ProtocolInvoker, error) {
+	return p.f.new(ctx, p.address)
+}
+
+// Stats reports the connection pool, it is empty if the pool does not
+// implement StatsReporter.
+func (p *InvokerPool) Stats() PoolStats {
+	if r, ok := p.f.cpool.(StatsReporter); ok {
+		return r.Stats()
+	}
+	return PoolStats{}
+}
+
+func (p *InvokerPool) Close() error {
+	return p.f.cpool.Close()
 }
 
 type factory struct {
@@ -165,7 +203,11 @@ type factory struct {
 }
 
 func (f *factory) New(address string) (ProtocolInvoker, error) {
-	conn, err := f.cpool.Take(context.TODO(), address)
+	return f.new(context.TODO(), address)
+}
+
+func (f *factory) new(ctx context.Context, address string) (ProtocolInvoker, error) {
+	conn, err := f.cpool.Take(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +226,15 @@ type protocolInvoker struct {
 
 func (c *protocolInvoker) Protocol() *Protocol { return c.p }
 
+// Close gives back the connection and the protocol, it does nothing if
+// the invoker has been closed.
 func (c *protocolInvoker) Close() error {
+	if c.c == nil {
+		return nil
+	}
 	c.f.ppool.Put(c.p)
 	c.f.cpool.Put(c.c)
+	c.c, c.p = nil, nil
 	return nil
 }
 
@@ -197,17 +245,24 @@ func (c *protocolInvoker) Invoke(ctx context.Context, method string, arg, ret in
 	if prot, ok := ctx.Value(clientProtocolCtxKey{}).(*Protocol); !ok || prot != c.p {
 		ctx = context.WithValue(ctx, clientProtocolCtxKey{}, c.p)
 	}
-	err = invoke(ctx, method, arg, ret)
+	opts := applyCallOptions(ctx, c.f.opts, options)
+	// headers are cleared when the request is flushed, keep them for retry
+	headers := c.p.Headers()
+	err = invoke(ctx, method, arg, ret, opts)
 	if err != nil && err == ErrPeerClosed && c.c.IsReused() {
-		// retry on reused & peer closed connection
+		// retry on reused & peer closed connection, the old connection
+		// is given back first, which may be needed by the new one
 		c.Close()
-		newInvoker, err := c.f.New(c.address)
+		newInvoker, err := c.f.new(ctx, c.address)
 		if err != nil {
 			return err
 		}
 
 		*c = *(newInvoker.(*protocolInvoker))
-		return c.Invoke(ctx, method, arg, ret)
+		for k, v := range headers {
+			c.p.SetHeader(k, v)
+		}
+		return c.Invoke(ctx, method, arg, ret, options...)
 	}
 	return err
 }
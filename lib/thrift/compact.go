@@ -25,11 +25,15 @@ func (r *compactReader) ReadMessageBegin() (name string, typeId MessageType, seq
 	if protoID, err = r.prot.preReadMessageBegin(); err != nil {
 		return
 	}
-	// the protocol may be changed during preReadMessageBegin
+	// the protocol may be changed during preReadMessageBegin, the transport
+	// header has already been consumed, so don't call ReadMessageBegin again
 	if protoID != ProtocolIDCompact {
-		return r.prot.ReadMessageBegin()
+		return (*binaryReader)(r).readMessageBegin()
 	}
+	return r.readMessageBegin()
+}
 
+func (r *compactReader) readMessageBegin() (name string, typeId MessageType, seqid int32, err error) {
 	b := r.tmp[:2]
 	if _, err = r.Read(b); err != nil {
 		return
@@ -91,7 +95,7 @@ func (r *compactReader) ReadFieldBegin() (name string, typeId Type, id int16, er
 
 	// if this happens to be a boolean field, the value is encoded in the type
 	if typeId == BOOL {
-		r.pendingBoolField = t
+		r.pendingBoolField = t & 0x0f
 	}
 
 	// push the new field onto the field stack so we can keep the deltas going.
@@ -175,7 +179,9 @@ func (r *compactReader) ReadBool() (value bool, err error) {
 		}
 		return v == 1, nil
 	}
-	return r.pendingBoolField == 1, nil
+	value = r.pendingBoolField == COMPACT_BOOLEAN_TRUE
+	r.pendingBoolField = 0
+	return value, nil
 }
 
 func (r *compactReader) ReadI16() (value int16, err error) {
@@ -507,6 +513,11 @@ func (w *compactWriter) WriteBinary(value []byte) error {
 	return err
 }
 
+func (w *compactWriter) WriteRaw(raw []byte) error {
+	_, err := w.Write(raw)
+	return err
+}
+
 func (w *compactWriter) Flush() error {
 	if err := w.Writer.Flush(); err != nil {
 		return err
@@ -136,3 +136,59 @@ func TestCompactSkip(t *testing.T) {
 	is.Equal(skipped, rawBytes[3:])
 	is.Equal(buf.Len(), 0)
 }
+
+func TestCompactBoolFields(t *testing.T) {
+	is := is.New(t)
+
+	var buf bytes.Buffer
+	var p = NewProtocol(nil, WithCompact()(DefaultOptions))
+	p.Reset(&buf)
+
+	var w = p // Writer
+	w.WriteStructBegin("")
+	w.WriteFieldBegin("", BOOL, 1) // delta encoded id
+	w.WriteBool(true)
+	w.WriteFieldBegin("", BOOL, 2)
+	w.WriteBool(false)
+	w.WriteFieldBegin("", BOOL, 20) // long form id
+	w.WriteBool(true)
+	w.WriteFieldBegin("", LIST, 21)
+	w.WriteListBegin(BOOL, 2)
+	w.WriteBool(false)
+	w.WriteBool(true)
+	w.WriteFieldStop()
+	w.WriteStructEnd()
+	is.NoErr(w.Flush())
+
+	var r = p // Reader
+	_, err := r.ReadStructBegin()
+	is.NoErr(err)
+	for _, want := range []struct {
+		id    int16
+		value bool
+	}{{1, true}, {2, false}, {20, true}} {
+		_, tp, id, err := r.ReadFieldBegin()
+		is.NoErr(err)
+		is.True(tp == BOOL)
+		is.Equal(id, want.id)
+		b, err := r.ReadBool()
+		is.NoErr(err)
+		is.Equal(b, want.value)
+	}
+
+	// the value of the last bool field is not returned again
+	_, tp, id, err := r.ReadFieldBegin()
+	is.NoErr(err)
+	is.True(tp == LIST)
+	is.Equal(id, int16(21))
+	et, size, err := r.ReadListBegin()
+	is.NoErr(err)
+	is.True(et == BOOL)
+	is.Equal(size, 2)
+	b, err := r.ReadBool()
+	is.NoErr(err)
+	is.Equal(b, false)
+	b, err = r.ReadBool()
+	is.NoErr(err)
+	is.Equal(b, true)
+}
@@ -1,11 +1,13 @@
 package thrift
 
 import (
+	"container/list"
 	"context"
 	"crypto/tls"
 	"errors"
 	"io"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -32,21 +34,30 @@ func (d Dialer) WithRetry(n int) Dialer {
 	}
 }
 
+// StdDialer dials TCP "host:port" addresses, as well as Unix domain
+// sockets addressed as "unix:///path/to/socket" or "unix://@abstract-name".
 var StdDialer Dialer = func(ctx context.Context, address string) (net.Conn, error) {
 	var d net.Dialer
-	return d.DialContext(ctx, "tcp", address)
+	network, address := parseAddress(address)
+	return d.DialContext(ctx, network, address)
 }
 
 func NewTimeoutDialer(timeout time.Duration) Dialer {
 	return func(ctx context.Context, address string) (net.Conn, error) {
 		var d = net.Dialer{Timeout: timeout}
-		return d.DialContext(ctx, "tcp", address)
+		network, address := parseAddress(address)
+		return d.DialContext(ctx, network, address)
 	}
 }
 
+// NewTlsDialer returns a Dialer which establishes TLS connections, the
+// handshake is done within the dialing and respects ctx. For mutual TLS,
+// set tlsCfg.Certificates or tlsCfg.GetClientCertificate.
 func NewTlsDialer(tlsCfg *tls.Config) Dialer {
 	return func(ctx context.Context, address string) (net.Conn, error) {
-		return tls.Dial("tcp", address, tlsCfg)
+		d := tls.Dialer{Config: tlsCfg}
+		network, address := parseAddress(address)
+		return d.DialContext(ctx, network, address)
 	}
 }
 
@@ -56,6 +67,32 @@ type Pool interface {
 	Close() error
 }
 
+// StatsReporter is implemented by the pools which report their stats,
+// e.g. the one returned by NewPool.
+type StatsReporter interface {
+	Stats() PoolStats
+}
+
+// PoolStats reports what a connection pool is doing.
+type PoolStats struct {
+	Total int // connections to all peers, both in use and idle
+	Peers []PeerStats
+}
+
+type PeerStats struct {
+	Address string
+
+	Active  int // connections in use
+	Idle    int // idle connections
+	Waiting int // callers waiting for a connection
+
+	DialErrors uint64
+	Evictions  uint64 // idle connections closed for expiry or room of other peers
+
+	Waits    uint64        // times of waiting for a connection
+	WaitTime time.Duration // total time spent in waiting
+}
+
 type Conn interface {
 	net.Conn
 	SetTimeout(t time.Duration) error
@@ -72,7 +109,9 @@ type pool struct {
 
 	mu       sync.Mutex
 	peers    sync.Map
+	total    *limiter
 	isClosed bool
+	done     chan struct{}
 }
 
 func NewPool(dialer Dialer, opts ...Option) Pool {
@@ -80,12 +119,21 @@ func NewPool(dialer Dialer, opts ...Option) Pool {
 	for _, opt := range opts {
 		pool.opts = opt(pool.opts)
 	}
+	pool.total = &limiter{limit: pool.opts.maxTotal, maxWait: pool.opts.maxWait}
+	// Expired connections are also evicted when taking, the janitor is
+	// only needed to close idle connections timely or keep minIdle ones.
+	needJanitor := pool.opts.minIdle > 0 || pool.opts.idleTimeout > 0
+	if pool.opts.maxIdle > 0 && pool.opts.idleCheckInterval > 0 && needJanitor {
+		pool.done = make(chan struct{})
+		go pool.janitor(pool.opts.idleCheckInterval)
+	}
 	return pool
 }
 
 func (p *pool) Take(ctx context.Context, address string) (Conn, error) {
 	var pp *peer
-	if x, ok := p.peers.Load(address); ok {
+	key := peerKey(address)
+	if x, ok := p.peers.Load(key); ok {
 		pp = x.(*peer)
 		return pp.take(ctx)
 	}
@@ -94,12 +142,12 @@ func (p *pool) Take(ctx context.Context, address string) (Conn, error) {
 	pp = &peer{
 		pool:    p,
 		address: address,
+		closed:  p.isClosed,
 	}
-	if p.opts.maxIdle > 0 && !p.isClosed {
-		pp.free = make(chan *clientconn, p.opts.maxIdle)
-	}
-	if x, loaded := p.peers.LoadOrStore(address, pp); loaded {
+	if x, loaded := p.peers.LoadOrStore(key, pp); loaded {
 		pp = x.(*peer)
+	} else if !pp.closed && p.opts.minIdle > 0 {
+		go pp.warmUp()
 	}
 	p.mu.Unlock()
 	return pp.take(ctx)
@@ -117,6 +165,9 @@ func (p *pool) Close() error {
 		return nil
 	}
 	p.isClosed = true
+	if p.done != nil {
+		close(p.done)
+	}
 	p.peers.Range(func(k, v interface{}) bool {
 		pp := v.(*peer)
 		pp.close()
@@ -125,77 +176,374 @@ func (p *pool) Close() error {
 	return nil
 }
 
+func (p *pool) Stats() PoolStats {
+	var stats PoolStats
+	p.peers.Range(func(k, v interface{}) bool {
+		ps := v.(*peer).stats()
+		stats.Total += ps.Active + ps.Idle
+		stats.Peers = append(stats.Peers, ps)
+		return true
+	})
+	sort.Slice(stats.Peers, func(i, j int) bool {
+		return stats.Peers[i].Address < stats.Peers[j].Address
+	})
+	return stats
+}
+
+// janitor evicts expired idle connections and keeps minIdle connections
+// for each peer periodically, until the pool is closed.
+func (p *pool) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.peers.Range(func(k, v interface{}) bool {
+				pp := v.(*peer)
+				pp.evictExpired()
+				pp.warmUp()
+				return true
+			})
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// evictIdle closes the oldest idle connection of any peer, to make room
+// for new connection when the maxTotal limit is reached.
+func (p *pool) evictIdle() {
+	p.peers.Range(func(k, v interface{}) bool {
+		pp := v.(*peer)
+		pp.mu.Lock()
+		if len(pp.idle) == 0 {
+			pp.mu.Unlock()
+			return true
+		}
+		conn := pp.idle[0]
+		pp.idle = pp.idle[1:]
+		pp.evictions++
+		pp.mu.Unlock()
+		pp.discard(conn)
+		return false
+	})
+}
+
 type peer struct {
 	pool    *pool
 	address string
 
-	mu     sync.RWMutex
-	free   chan *clientconn
-	active int32 // atomic
+	mu      sync.Mutex
+	idle    []*clientconn
+	active  int       // connections taken or idle
+	waiters list.List // of chan *clientconn
+	closed  bool
+
+	dialErrors uint64
+	evictions  uint64
+	waits      uint64
+	waitTime   time.Duration
 }
 
 func (p *peer) take(ctx context.Context) (*clientconn, error) {
-	select {
-	case conn := <-p.free:
-		if conn.isExpired() {
-			atomic.AddInt32(&p.active, -1)
-			conn.Conn.Close()
-			return p.take(ctx)
+	opts := p.pool.opts
+	p.mu.Lock()
+	for n := len(p.idle); n > 0; n = len(p.idle) {
+		conn := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		if !conn.isExpired() {
+			p.mu.Unlock()
+			return conn, nil
 		}
-		return conn, nil
-	default:
-		// pass
+		conn.Conn.Close()
+		p.pool.total.release()
+		p.active--
+		p.evictions++
 	}
-	active := atomic.AddInt32(&p.active, 1)
-	if p.pool.opts.maxActive > 0 && int(active) > p.pool.opts.maxActive {
-		atomic.AddInt32(&p.active, -1)
-		return nil, ErrTooManyConn
+	if opts.maxActive > 0 && p.active >= opts.maxActive {
+		// Wait for a connection to be put back or closed.
+		if p.waiters.Len() >= opts.maxWait {
+			p.mu.Unlock()
+			return nil, ErrTooManyConn
+		}
+		ch := make(chan *clientconn, 1)
+		elem := p.waiters.PushBack(ch)
+		p.mu.Unlock()
+		start := time.Now()
+		select {
+		case conn := <-ch:
+			p.addWait(time.Since(start))
+			if conn != nil {
+				return conn, nil
+			}
+		case <-ctx.Done():
+			p.addWait(time.Since(start))
+			p.mu.Lock()
+			select {
+			case conn := <-ch:
+				// Handed over concurrently, give it back.
+				p.mu.Unlock()
+				if conn != nil {
+					p.put(conn)
+				} else {
+					p.release()
+				}
+			default:
+				p.waiters.Remove(elem)
+				p.mu.Unlock()
+			}
+			return nil, ctx.Err()
+		}
+	} else {
+		p.active++
+		p.mu.Unlock()
 	}
 
-	// Establish new connection.
+	// Got a slot, establish new connection.
+	var err error
+	if p.pool.total.full() {
+		p.pool.evictIdle()
+		start := time.Now()
+		err = p.pool.total.acquire(ctx)
+		p.addWait(time.Since(start))
+	} else {
+		err = p.pool.total.acquire(ctx)
+	}
+	if err != nil {
+		p.release()
+		return nil, err
+	}
+	return p.dial(ctx)
+}
+
+// dial establishes a new connection with the slots already acquired.
+func (p *peer) dial(ctx context.Context) (*clientconn, error) {
 	netConn, err := p.pool.dial(ctx, p.address)
 	if err != nil {
-		atomic.AddInt32(&p.active, -1)
+		p.mu.Lock()
+		p.dialErrors++
+		p.mu.Unlock()
+		p.pool.total.release()
+		p.release()
 		return nil, err
 	}
 	conn := newClientconn(netConn, p)
-	return conn, err
+	return conn, nil
+}
+
+// warmUp establishes connections until there are minIdle idle ones,
+// it never waits for the maxActive or maxTotal limit.
+func (p *peer) warmUp() {
+	opts := p.pool.opts
+	minIdle := opts.minIdle
+	if minIdle > opts.maxIdle {
+		minIdle = opts.maxIdle
+	}
+	for {
+		p.mu.Lock()
+		if p.closed || len(p.idle) >= minIdle ||
+			(opts.maxActive > 0 && p.active >= opts.maxActive) {
+			p.mu.Unlock()
+			return
+		}
+		p.active++
+		p.mu.Unlock()
+		if !p.pool.total.tryAcquire() {
+			p.release()
+			return
+		}
+		conn, err := p.dial(context.Background())
+		if err != nil {
+			return
+		}
+		conn.usedAt = time.Now()
+		p.put(conn)
+	}
+}
+
+// evictExpired closes expired idle connections.
+func (p *peer) evictExpired() {
+	var expired []*clientconn
+	p.mu.Lock()
+	idle := p.idle[:0]
+	for _, conn := range p.idle {
+		if conn.isExpired() {
+			expired = append(expired, conn)
+		} else {
+			idle = append(idle, conn)
+		}
+	}
+	for i := len(idle); i < len(p.idle); i++ {
+		p.idle[i] = nil
+	}
+	p.idle = idle
+	p.evictions += uint64(len(expired))
+	p.mu.Unlock()
+	for _, conn := range expired {
+		p.discard(conn)
+	}
+}
+
+func (p *peer) addWait(d time.Duration) {
+	p.mu.Lock()
+	p.waits++
+	p.waitTime += d
+	p.mu.Unlock()
+}
+
+func (p *peer) stats() PeerStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return PeerStats{
+		Address:    p.address,
+		Active:     p.active - len(p.idle),
+		Idle:       len(p.idle),
+		Waiting:    p.waiters.Len(),
+		DialErrors: p.dialErrors,
+		Evictions:  p.evictions,
+		Waits:      p.waits,
+		WaitTime:   p.waitTime,
+	}
 }
 
 func (p *peer) put(conn *clientconn) error {
 	if err := conn.getError(); err != nil {
-		atomic.AddInt32(&p.active, -1)
-		return conn.Conn.Close()
+		return p.discard(conn)
 	}
 	conn.usedAt = time.Now()
-	p.mu.RLock()
-	defer p.mu.RUnlock()
-	select {
-	case p.free <- conn:
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return p.discard(conn)
+	}
+	if elem := p.waiters.Front(); elem != nil {
+		p.waiters.Remove(elem)
+		elem.Value.(chan *clientconn) <- conn
+		p.mu.Unlock()
+		return nil
+	}
+	if len(p.idle) < p.pool.opts.maxIdle {
+		p.idle = append(p.idle, conn)
+		p.mu.Unlock()
 		return nil
-	default:
-		atomic.AddInt32(&p.active, -1)
-		return conn.Conn.Close()
 	}
+	p.mu.Unlock()
+	return p.discard(conn)
 }
 
-func (p *peer) close() error {
+// discard closes conn and releases its slots.
+func (p *peer) discard(conn *clientconn) error {
+	err := conn.Conn.Close()
+	p.pool.total.release()
+	p.release()
+	return err
+}
+
+// release releases a connection slot, the slot is handed over to the
+// first waiter if there is any.
+func (p *peer) release() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	if p.free == nil {
+	if elem := p.waiters.Front(); elem != nil {
+		p.waiters.Remove(elem)
+		elem.Value.(chan *clientconn) <- nil
+		return
+	}
+	p.active--
+}
+
+func (p *peer) close() error {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mu.Unlock()
+	for _, conn := range idle {
+		p.discard(conn)
+	}
+	return nil
+}
+
+// limiter limits the number of connections across peers, callers exceed
+// the limit wait in a bounded FIFO queue.
+type limiter struct {
+	limit   int // zero means no limit
+	maxWait int
+
+	mu      sync.Mutex
+	used    int
+	waiters list.List // of chan struct{}
+}
+
+func (l *limiter) full() bool {
+	if l.limit <= 0 {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.used >= l.limit
+}
+
+func (l *limiter) tryAcquire() bool {
+	if l.limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.used < l.limit && l.waiters.Len() == 0 {
+		l.used++
+		return true
+	}
+	return false
+}
+
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.limit <= 0 {
 		return nil
 	}
-	for {
+	l.mu.Lock()
+	if l.used < l.limit {
+		l.used++
+		l.mu.Unlock()
+		return nil
+	}
+	if l.waiters.Len() >= l.maxWait {
+		l.mu.Unlock()
+		return ErrTooManyConn
+	}
+	ch := make(chan struct{}, 1)
+	elem := l.waiters.PushBack(ch)
+	l.mu.Unlock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
 		select {
-		case conn := <-p.free:
-			if err := conn.Conn.Close(); err != nil {
-				// TODO
-			}
+		case <-ch:
+			// Handed over concurrently, give it back.
+			l.mu.Unlock()
+			l.release()
 		default:
-			p.free = nil
-			return nil
+			l.waiters.Remove(elem)
+			l.mu.Unlock()
 		}
+		return ctx.Err()
+	}
+}
+
+func (l *limiter) release() {
+	if l.limit <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elem := l.waiters.Front(); elem != nil {
+		l.waiters.Remove(elem)
+		elem.Value.(chan struct{}) <- struct{}{}
+		return
 	}
+	l.used--
 }
 
 type clientconn struct {
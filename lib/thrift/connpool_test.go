@@ -0,0 +1,150 @@
+package thrift
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func testListener(is *is.I) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	is.NoErr(err)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	return ln
+}
+
+func TestPoolWaitQueue(t *testing.T) {
+	is := is.New(t)
+	ln := testListener(is)
+	defer ln.Close()
+	addr := ln.Addr().String()
+
+	cpool := NewPool(StdDialer, WithMaxIdle(1), WithMaxActive(1), WithMaxWaitQueue(1))
+	defer cpool.Close()
+	conn, err := cpool.Take(context.Background(), addr)
+	is.NoErr(err)
+
+	// times out when no connection is put back
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	_, err = cpool.Take(ctx, addr)
+	cancel()
+	is.Equal(err, context.DeadlineExceeded)
+
+	// the waiter gets the connection put back
+	got := make(chan Conn)
+	go func() {
+		conn, _ := cpool.Take(context.Background(), addr)
+		got <- conn
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// the wait queue is full
+	_, err = cpool.Take(context.Background(), addr)
+	is.Equal(err, ErrTooManyConn)
+
+	is.NoErr(cpool.Put(conn))
+	is.Equal(<-got, conn)
+}
+
+func TestPoolNoWaitByDefault(t *testing.T) {
+	is := is.New(t)
+	ln := testListener(is)
+	defer ln.Close()
+	addr := ln.Addr().String()
+
+	cpool := NewPool(StdDialer, WithMaxActive(1))
+	defer cpool.Close()
+	conn, err := cpool.Take(context.Background(), addr)
+	is.NoErr(err)
+	_, err = cpool.Take(context.Background(), addr)
+	is.Equal(err, ErrTooManyConn)
+	is.NoErr(cpool.Put(conn))
+
+	// the invokers of the factory take connections without deadline
+	factory := NewProtocolInvokerFactory(StdDialer, WithMaxActive(1))
+	invoker, err := factory(addr)
+	is.NoErr(err)
+	_, err = factory(addr)
+	is.Equal(err, ErrTooManyConn)
+	is.NoErr(invoker.Close())
+}
+
+func TestPoolMaxTotal(t *testing.T) {
+	is := is.New(t)
+	ln1, ln2 := testListener(is), testListener(is)
+	defer ln1.Close()
+	defer ln2.Close()
+
+	cpool := NewPool(StdDialer, WithMaxIdle(1), WithMaxTotal(1), WithMaxWaitQueue(1))
+	defer cpool.Close()
+	conn, err := cpool.Take(context.Background(), ln1.Addr().String())
+	is.NoErr(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	_, err = cpool.Take(ctx, ln2.Addr().String())
+	cancel()
+	is.Equal(err, context.DeadlineExceeded)
+
+	// idle connection of other peers is evicted to make room
+	is.NoErr(cpool.Put(conn))
+	conn, err = cpool.Take(context.Background(), ln2.Addr().String())
+	is.NoErr(err)
+	is.Equal(conn.RemoteAddr().String(), ln2.Addr().String())
+}
+
+func TestPoolJanitor(t *testing.T) {
+	is := is.New(t)
+
+	// expired connections are evicted when taken, no janitor is needed
+	cpool := NewPool(StdDialer, WithMaxIdle(1))
+	is.True(cpool.(*pool).done == nil)
+	is.NoErr(cpool.Close())
+
+	for _, opt := range []Option{WithMinIdle(1), WithIdleTimeout(time.Second)} {
+		cpool = NewPool(StdDialer, WithMaxIdle(1), opt)
+		is.True(cpool.(*pool).done != nil)
+		is.NoErr(cpool.Close())
+	}
+}
+
+func TestPoolMaintenance(t *testing.T) {
+	is := is.New(t)
+	ln := testListener(is)
+	defer ln.Close()
+	addr := ln.Addr().String()
+
+	cpool := NewPool(StdDialer, WithMaxIdle(2), WithMinIdle(2),
+		WithMaxAge(50*time.Millisecond), WithIdleCheckInterval(10*time.Millisecond))
+	defer cpool.Close()
+	conn, err := cpool.Take(context.Background(), addr)
+	is.NoErr(err)
+	is.NoErr(cpool.Put(conn))
+
+	// expired connections are evicted and replenished in background
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		stats := cpool.(StatsReporter).Stats()
+		is.Equal(len(stats.Peers), 1)
+		ps := stats.Peers[0]
+		if ps.Idle == 2 && ps.Evictions >= 2 {
+			is.Equal(ps.Active, 0)
+			is.Equal(stats.Total, 2)
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected stats: %+v", ps)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,71 @@
+package thrift
+
+import "context"
+
+type outgoingHeadersCtxKey struct{}
+
+// WithOutgoingHeaders returns a new context carrying headers to be sent
+// with the requests made by clients using the context, which are merged
+// with the ones already carried by ctx. It works with header transport
+// only, headers are silently dropped by other transports.
+func WithOutgoingHeaders(ctx context.Context, kv map[string]string) context.Context {
+	headers := make(map[string]string, len(kv))
+	for k, v := range OutgoingHeaders(ctx) {
+		headers[k] = v
+	}
+	for k, v := range kv {
+		headers[k] = v
+	}
+	return context.WithValue(ctx, outgoingHeadersCtxKey{}, headers)
+}
+
+// OutgoingHeaders returns the headers set by WithOutgoingHeaders, the
+// returned map must not be modified.
+func OutgoingHeaders(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(outgoingHeadersCtxKey{}).(map[string]string)
+	return headers
+}
+
+// IncomingHeaders returns the headers of the request being served, it
+// returns nil if ctx does not come from a server.
+func IncomingHeaders(ctx context.Context) map[string]string {
+	if p := ProtocolFromCtx(ctx); p != nil {
+		return p.ReadHeaders()
+	}
+	return nil
+}
+
+// SetResponseHeader sets a header to be sent with the reply of the request
+// being served, it works with header transport only.
+func SetResponseHeader(ctx context.Context, key, value string) {
+	if p := ProtocolFromCtx(ctx); p != nil {
+		p.SetHeader(key, value)
+	}
+}
+
+// setRequestHeaders writes the forwarded incoming headers and outgoing
+// headers to p, the headers already set to p are not overridden.
+func setRequestHeaders(ctx context.Context, p *Protocol, forward []string) {
+	if len(forward) > 0 {
+		if incoming := ProtocolFromCtx(ctx); incoming != nil {
+			outgoing := OutgoingHeaders(ctx)
+			for _, k := range forward {
+				if _, ok := outgoing[k]; ok {
+					continue
+				}
+				if v, ok := incoming.ReadHeader(k); ok {
+					setHeaderIfAbsent(p, k, v)
+				}
+			}
+		}
+	}
+	for k, v := range OutgoingHeaders(ctx) {
+		setHeaderIfAbsent(p, k, v)
+	}
+}
+
+func setHeaderIfAbsent(p *Protocol, k, v string) {
+	if _, ok := p.Header(k); !ok {
+		p.SetHeader(k, v)
+	}
+}
@@ -0,0 +1,180 @@
+package thrift
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// funcProcessor replies every call with the string returned by handle.
+type funcProcessor func(ctx context.Context, s string) string
+
+func (handle funcProcessor) Process(ctx context.Context, r Reader, w Writer) error {
+	for {
+		name, _, seqid, err := r.ReadMessageBegin()
+		if err != nil {
+			return err
+		}
+		var args echoStruct
+		if err = args.Read(r); err != nil {
+			return err
+		}
+		ret := &echoStruct{S: handle(ctx, args.S)}
+		if err = w.WriteMessageBegin(name, REPLY, seqid); err != nil {
+			return err
+		}
+		if err = ret.Write(w); err != nil {
+			return err
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func TestForwardHeaders(t *testing.T) {
+	is := is.New(t)
+
+	backend := NewServer(funcProcessor(func(ctx context.Context, s string) string {
+		headers := IncomingHeaders(ctx)
+		return headers["tenant"] + "," + headers["flag"] + "," + headers["secret"]
+	}), WithHeader())
+	is.NoErr(backend.Listen("127.0.0.1:0"))
+	go backend.Serve()
+	defer backend.Stop()
+
+	downstream := NewClient(StdDialer, backend.listener.Addr().String(),
+		WithHeader(), WithForwardHeaders("tenant", "flag"))
+	defer downstream.Close()
+	frontend := NewServer(funcProcessor(func(ctx context.Context, s string) string {
+		if s == "override" {
+			ctx = WithOutgoingHeaders(ctx, map[string]string{"flag": "b"})
+		}
+		ret := &echoStruct{}
+		if err := downstream.Invoke(ctx, "Echo", &echoStruct{}, ret); err != nil {
+			return err.Error()
+		}
+		return ret.S
+	}), WithHeader())
+	is.NoErr(frontend.Listen("127.0.0.1:0"))
+	go frontend.Serve()
+	defer frontend.Stop()
+
+	cli := NewClient(StdDialer, frontend.listener.Addr().String(), WithHeader())
+	defer cli.Close()
+	ctx := WithOutgoingHeaders(context.Background(), map[string]string{"tenant": "t1", "secret": "s"})
+	ctx = WithOutgoingHeaders(ctx, map[string]string{"flag": "a"})
+	ret := &echoStruct{}
+	is.NoErr(cli.Invoke(ctx, "Echo", &echoStruct{}, ret))
+	is.Equal(ret.S, "t1,a,") // secret is not forwarded
+	is.NoErr(cli.Invoke(ctx, "Echo", &echoStruct{S: "override"}, ret))
+	is.Equal(ret.S, "t1,b,")
+}
+
+func TestResponseHeaders(t *testing.T) {
+	is := is.New(t)
+
+	server := NewServer(funcProcessor(func(ctx context.Context, s string) string {
+		SetResponseHeader(ctx, "cursor", s+"-next")
+		return s
+	}), WithHeader())
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+
+	cli := NewClient(StdDialer, server.listener.Addr().String(), WithHeader())
+	defer cli.Close()
+	ret := &echoStruct{}
+	headers := make(map[string]string)
+	is.NoErr(cli.Invoke(context.Background(), "Echo", &echoStruct{S: "a"}, ret, WithResponseHeaders(headers)))
+	is.Equal(headers["cursor"], "a-next")
+
+	headers = make(map[string]string)
+	ctx := WithCallOptions(context.Background(), WithResponseHeaders(headers))
+	is.NoErr(cli.Invoke(ctx, "Echo", &echoStruct{S: "b"}, ret))
+	is.Equal(headers["cursor"], "b-next")
+}
+
+// oneshotProcessor replies a single call and closes the connection.
+type oneshotProcessor struct{ funcProcessor }
+
+func (p oneshotProcessor) Process(ctx context.Context, r Reader, w Writer) error {
+	name, _, seqid, err := r.ReadMessageBegin()
+	if err != nil {
+		return err
+	}
+	var args echoStruct
+	if err = args.Read(r); err != nil {
+		return err
+	}
+	ret := &echoStruct{S: p.funcProcessor(ctx, args.S)}
+	if err = w.WriteMessageBegin(name, REPLY, seqid); err != nil {
+		return err
+	}
+	if err = ret.Write(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func TestInvokerRetryHeaders(t *testing.T) {
+	is := is.New(t)
+
+	server := NewServer(oneshotProcessor{func(ctx context.Context, s string) string {
+		return IncomingHeaders(ctx)["tenant"]
+	}}, WithHeader())
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+
+	pool := NewInvokerPool(StdDialer, server.listener.Addr().String(), WithHeader(), WithMaxIdle(1))
+	defer pool.Close()
+	for _, tenant := range []string{"t1", "t2"} {
+		invoker, err := pool.Get(context.Background())
+		is.NoErr(err)
+		// the second call is retried on a new connection
+		invoker.Protocol().SetHeader("tenant", tenant)
+		ret := &echoStruct{}
+		is.NoErr(invoker.Invoke(context.Background(), "Echo", &echoStruct{}, ret))
+		is.Equal(ret.S, tenant)
+		invoker.Close()
+	}
+}
+
+func TestInvokerRetryFailure(t *testing.T) {
+	is := is.New(t)
+
+	server := NewServer(oneshotProcessor{func(ctx context.Context, s string) string {
+		return s
+	}})
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+
+	var dials int32
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		if atomic.AddInt32(&dials, 1) > 1 {
+			return nil, errors.New("dial failed")
+		}
+		return StdDialer(ctx, address)
+	}
+	pool := NewInvokerPool(dialer, server.listener.Addr().String(), WithMaxIdle(1))
+	defer pool.Close()
+	invoker, err := pool.Get(context.Background())
+	is.NoErr(err)
+	is.NoErr(invoker.Invoke(context.Background(), "Echo", &echoStruct{}, &echoStruct{}))
+	invoker.Close()
+
+	// the retry fails to dial, the invoker is given back only once
+	invoker, err = pool.Get(context.Background())
+	is.NoErr(err)
+	is.True(invoker.Invoke(context.Background(), "Echo", &echoStruct{}, &echoStruct{}) != nil)
+	is.NoErr(invoker.Close())
+	stats := pool.Stats()
+	is.Equal(stats.Total, 0)
+	is.Equal(stats.Peers[0].Active, 0)
+}
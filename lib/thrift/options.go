@@ -1,6 +1,10 @@
 package thrift
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
 	"time"
 )
 
@@ -10,6 +14,11 @@ type options struct {
 	maxAge    time.Duration
 	maxIdle   int
 	maxActive int
+	maxTotal  int
+	maxWait   int
+	minIdle   int
+
+	idleCheckInterval time.Duration
 
 	rTimeout    time.Duration
 	wTimeout    time.Duration
@@ -22,17 +31,36 @@ type options struct {
 	nocopy  bool // TODO
 	header  bool
 	protoID ProtocolID
+
+	// server side transport detection
+	detect      bool
+	httpHandler http.Handler
+
+	// time limit of TLS handshake and transport detection
+	handshakeTimeout time.Duration
+
+	tlsConfig *tls.Config
+
+	// incoming headers forwarded to downstream calls
+	forwardHeaders []string
+
+	// captures response headers of a call
+	responseHeaders map[string]string
 }
 
 var DefaultOptions = options{
 	maxAge:    2 * time.Second,
 	maxIdle:   0, // no keepalive
 	maxActive: 10000,
-	rbufsz:    2048,
-	wbufsz:    2048,
+	maxWait:   0, // no waiting
+
+	idleCheckInterval: time.Second,
+	handshakeTimeout:  10 * time.Second,
+	rbufsz:            2048,
+	wbufsz:            2048,
 	//nocopy:    true,
-	header:    false,
-	protoID:   ProtocolIDBinary,
+	header:  false,
+	protoID: ProtocolIDBinary,
 }
 
 // WithMaxAge limits the age of connection in client cpool.
@@ -59,6 +87,48 @@ func WithMaxActive(n int) Option {
 	}
 }
 
+// WithMinIdle keeps at least n idle connections to each peer, the
+// connections are established in background once a peer is used.
+// It is limited by maxIdle.
+func WithMinIdle(n int) Option {
+	return func(o options) options {
+		o.minIdle = n
+		return o
+	}
+}
+
+// WithIdleCheckInterval sets how often the client cpool evicts expired
+// idle connections and replenishes connections for WithMinIdle.
+// Zero disables the background checking, which only runs with WithMinIdle
+// or WithIdleTimeout, expired connections are otherwise evicted when
+// they are taken.
+func WithIdleCheckInterval(t time.Duration) Option {
+	return func(o options) options {
+		o.idleCheckInterval = t
+		return o
+	}
+}
+
+// WithMaxTotal limits the number of connections to all peers of the
+// client cpool, zero means no limit.
+func WithMaxTotal(n int) Option {
+	return func(o options) options {
+		o.maxTotal = n
+		return o
+	}
+}
+
+// WithMaxWaitQueue limits the number of callers waiting for a connection
+// when the maxActive or maxTotal limit is reached. Waiting callers are
+// served in FIFO order until their context is done. Zero, the default,
+// disables waiting, ErrTooManyConn is returned immediately.
+func WithMaxWaitQueue(n int) Option {
+	return func(o options) options {
+		o.maxWait = n
+		return o
+	}
+}
+
 // WithFramed enables framed transport with `maxframesize` size of single frame
 func WithFramed(max int) Option {
 	return func(o options) options {
@@ -74,6 +144,56 @@ func WithHeader() Option {
 	}
 }
 
+// WithTransportDetection makes the server sniff the first bytes of every
+// new connection to pick the transport the client is speaking: unframed
+// binary/compact, framed or header. Connections which start with an HTTP
+// request line are handed off to h, if h is nil they are rejected.
+//
+// This is a server side option, it implies WithHeader since the header
+// transport is able to talk to unframed and framed clients as well.
+func WithTransportDetection(h http.Handler) Option {
+	return func(o options) options {
+		o.detect = true
+		o.header = true
+		o.httpHandler = h
+		return o
+	}
+}
+
+// WithHandshakeTimeout sets the time limit for new connections to complete
+// the TLS handshake and send the first bytes for transport detection,
+// which defaults to 10 seconds. Zero means no limit.
+func WithHandshakeTimeout(t time.Duration) Option {
+	return func(o options) options {
+		o.handshakeTimeout = t
+		return o
+	}
+}
+
+// WithTLS makes the server accept TLS connections only, using the given
+// configuration. To hot reload the certificate, set cfg.GetCertificate
+// to the method of a CertReloader.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o options) options {
+		o.tlsConfig = cfg
+		return o
+	}
+}
+
+// WithMutualTLS is like WithTLS, but also requires the clients to present
+// a certificate signed by one of clientCAs. The verified certificate of
+// a client is available to handlers by TLSPeerFromCtx.
+func WithMutualTLS(cfg *tls.Config, clientCAs *x509.CertPool) Option {
+	if cfg == nil {
+		cfg = &tls.Config{}
+	} else {
+		cfg = cfg.Clone()
+	}
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	cfg.ClientCAs = clientCAs
+	return WithTLS(cfg)
+}
+
 func DisableHeader() Option {
 	return func(o options) options {
 		o.header = false
@@ -121,6 +241,17 @@ func WithIdleTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithForwardHeaders makes the client forward the incoming headers of
+// the given keys to downstream calls, if the call is made with the context
+// of a request being served, e.g. tenant ID and feature flags.
+// Headers set by WithOutgoingHeaders take precedence.
+func WithForwardHeaders(keys ...string) Option {
+	return func(o options) options {
+		o.forwardHeaders = append(o.forwardHeaders[:len(o.forwardHeaders):len(o.forwardHeaders)], keys...)
+		return o
+	}
+}
+
 type CallOption func(o options) options
 
 func WithCallTimeout(r, w time.Duration) CallOption {
@@ -130,3 +261,39 @@ func WithCallTimeout(r, w time.Duration) CallOption {
 		return o
 	}
 }
+
+// WithResponseHeaders captures the headers of the response into headers,
+// which must not be nil.
+func WithResponseHeaders(headers map[string]string) CallOption {
+	return func(o options) options {
+		o.responseHeaders = headers
+		return o
+	}
+}
+
+type callOptionsCtxKey struct{}
+
+// WithCallOptions returns a new context carrying call options, which are
+// applied to the calls made with the context, before the options passed
+// to Invoke. It is useful with generated clients, e.g.
+//
+//	headers := make(map[string]string)
+//	ctx = thrift.WithCallOptions(ctx, thrift.WithResponseHeaders(headers))
+//	rsp, err := client.SomeMethod(ctx, req)
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	prev, _ := ctx.Value(callOptionsCtxKey{}).([]CallOption)
+	all := make([]CallOption, 0, len(prev)+len(opts))
+	all = append(append(all, prev...), opts...)
+	return context.WithValue(ctx, callOptionsCtxKey{}, all)
+}
+
+func applyCallOptions(ctx context.Context, opts options, callOpts []CallOption) options {
+	prev, _ := ctx.Value(callOptionsCtxKey{}).([]CallOption)
+	for _, opt := range prev {
+		opts = opt(opts)
+	}
+	for _, opt := range callOpts {
+		opts = opt(opts)
+	}
+	return opts
+}
@@ -79,6 +79,13 @@ type Writer interface {
 	Flush() (err error)
 }
 
+// RawWriter is implemented by the Writers which write encoded values
+// verbatim, e.g. the binary and compact writers, it is required to write
+// UnknownFields.
+type RawWriter interface {
+	WriteRaw(raw []byte) (err error)
+}
+
 type Protocol struct {
 	Reader
 	Writer
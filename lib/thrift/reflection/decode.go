@@ -14,8 +14,6 @@ func init() {
 
 var decodersCache sync.Map
 
-var byteSliceType = reflect.TypeOf(([]byte)(nil))
-
 func Read(val interface{}, r thrift.Reader) error {
 	decoder := DecoderOf(reflect.TypeOf(val))
 	return decoder.Decode(val, r)
@@ -43,7 +41,7 @@ func DecoderOf(valType reflect.Type) Decoder {
 }
 
 func decoderOf(prefix string, valType reflect.Type) internalDecoder {
-	if byteSliceType == valType {
+	if isBinaryType(valType) {
 		return &binaryDecoder{}
 	}
 	if isEnumType(valType) {
@@ -86,6 +84,7 @@ func decoderOf(prefix string, valType reflect.Type) internalDecoder {
 			elemType:    valType.Elem(),
 			sliceType:   valType,
 			elemDecoder: decoderOf(prefix+" [sliceElem]", valType.Elem()),
+			tType:       thrift.LIST,
 		}
 	case reflect.Map:
 		sampleObj := reflect.New(valType).Interface()
@@ -99,15 +98,21 @@ func decoderOf(prefix string, valType reflect.Type) internalDecoder {
 			tType:        thrift.MAP,
 		}
 		// FIXME: is there any reasonable way to auto distinct map and set?
-		if valType.Elem().Kind() == reflect.Bool {
+		if isSetElemType(valType.Elem()) {
 			decoder.tType = thrift.SET
 		}
 		return decoder
 	case reflect.Struct:
 		decoderFields := make([]structDecoderField, 0, valType.NumField())
+		var unknownOffset uintptr
+		var keepUnknown bool
 		decoderFieldMap := map[int16]structDecoderField{}
 		for i := 0; i < valType.NumField(); i++ {
 			refField := valType.Field(i)
+			if isUnknownField(refField) {
+				unknownOffset, keepUnknown = refField.Offset, true
+				continue
+			}
 			fieldId := parseFieldId(refField)
 			if fieldId == -1 {
 				continue
@@ -120,12 +125,18 @@ func decoderOf(prefix string, valType reflect.Type) internalDecoder {
 			if refField.Type.Kind() == reflect.Map {
 				decoderField.decoder.(*mapDecoder).tType = parseMapType(refField)
 			}
+			if x, ok := decoderField.decoder.(*sliceDecoder); ok && isSetField(refField) {
+				x.tType = thrift.SET
+			}
 			decoderFields = append(decoderFields, decoderField)
 			decoderFieldMap[fieldId] = decoderField
 		}
 		return &structDecoder{
 			fields:   decoderFields,
 			fieldMap: decoderFieldMap,
+
+			unknownOffset: unknownOffset,
+			keepUnknown:   keepUnknown,
 		}
 	}
 	return &unknownDecoder{prefix, valType}
@@ -47,7 +47,7 @@ func EncoderOf(valType reflect.Type) Encoder {
 }
 
 func encoderOf(prefix string, valType reflect.Type) internalEncoder {
-	if byteSliceType == valType {
+	if isBinaryType(valType) {
 		return &binaryEncoder{}
 	}
 	if isEnumType(valType) {
@@ -87,6 +87,7 @@ func encoderOf(prefix string, valType reflect.Type) internalEncoder {
 			sliceType:   valType,
 			elemType:    valType.Elem(),
 			elemEncoder: encoderOf(prefix+" [sliceElem]", valType.Elem()),
+			tType:       thrift.LIST,
 		}
 	case reflect.Map:
 		sampleObj := reflect.New(valType).Elem().Interface()
@@ -97,14 +98,20 @@ func encoderOf(prefix string, valType reflect.Type) internalEncoder {
 			tType:        thrift.MAP,
 		}
 		// FIXME: is there any reasonable way to auto distinct map and set?
-		if valType.Elem().Kind() == reflect.Bool {
+		if isSetElemType(valType.Elem()) {
 			encoder.tType = thrift.SET
 		}
 		return encoder
 	case reflect.Struct:
 		encoderFields := make([]structEncoderField, 0, valType.NumField())
+		var unknownOffset uintptr
+		var keepUnknown bool
 		for i := 0; i < valType.NumField(); i++ {
 			refField := valType.Field(i)
+			if isUnknownField(refField) {
+				unknownOffset, keepUnknown = refField.Offset, true
+				continue
+			}
 			fieldId := parseFieldId(refField)
 			if fieldId == -1 {
 				continue
@@ -117,10 +124,16 @@ func encoderOf(prefix string, valType reflect.Type) internalEncoder {
 			if refField.Type.Kind() == reflect.Map {
 				encoderField.encoder.(*mapEncoder).tType = parseMapType(refField)
 			}
+			if x, ok := encoderField.encoder.(*sliceEncoder); ok && isSetField(refField) {
+				x.tType = thrift.SET
+			}
 			encoderFields = append(encoderFields, encoderField)
 		}
 		return &structEncoder{
 			fields: encoderFields,
+
+			unknownOffset: unknownOffset,
+			keepUnknown:   keepUnknown,
 		}
 	case reflect.Ptr:
 		return &pointerEncoder{
@@ -10,13 +10,20 @@ type sliceDecoder struct {
 	sliceType   reflect.Type
 	elemType    reflect.Type
 	elemDecoder internalDecoder
+	tType       thrift.Type // LIST or SET
 }
 
 func (decoder *sliceDecoder) decode(ptr unsafe.Pointer, r thrift.Reader) error {
 	slice := (*sliceHeader)(ptr)
 	slice.Len = 0
 	offset := uintptr(0)
-	_, length, err := r.ReadListBegin()
+	var length int
+	var err error
+	if decoder.tType == thrift.SET {
+		_, length, err = r.ReadSetBegin()
+	} else {
+		_, length, err = r.ReadListBegin()
+	}
 	if err != nil {
 		return err
 	}
@@ -72,11 +79,18 @@ type sliceEncoder struct {
 	sliceType   reflect.Type
 	elemType    reflect.Type
 	elemEncoder internalEncoder
+	tType       thrift.Type // LIST or SET
 }
 
 func (encoder *sliceEncoder) encode(ptr unsafe.Pointer, w thrift.Writer) error {
 	slice := (*sliceHeader)(ptr)
-	if err := w.WriteListBegin(encoder.elemEncoder.thriftType(), slice.Len); err != nil {
+	var err error
+	if encoder.tType == thrift.SET {
+		err = w.WriteSetBegin(encoder.elemEncoder.thriftType(), slice.Len)
+	} else {
+		err = w.WriteListBegin(encoder.elemEncoder.thriftType(), slice.Len)
+	}
+	if err != nil {
 		return err
 	}
 	offset := uintptr(slice.Data)
@@ -95,5 +109,8 @@ func (encoder *sliceEncoder) encode(ptr unsafe.Pointer, w thrift.Writer) error {
 }
 
 func (encoder *sliceEncoder) thriftType() thrift.Type {
+	if encoder.tType == thrift.SET {
+		return thrift.SET
+	}
 	return thrift.LIST
 }
@@ -71,12 +71,16 @@ func (decoder *mapDecoder) readMap(mapVal reflect.Value, length int, r thrift.Re
 }
 
 func (decoder *mapDecoder) readSet(mapVal reflect.Value, length int, r thrift.Reader) error {
+	elemVal := reflectTrueValue
+	if decoder.elemType.Kind() != reflect.Bool {
+		elemVal = reflect.Zero(decoder.elemType) // struct{}{}
+	}
 	for i := 0; i < length; i++ {
 		keyVal := reflect.New(decoder.keyType)
 		if err := decoder.keyDecoder.decode(unsafe.Pointer(keyVal.Pointer()), r); err != nil {
 			return err
 		}
-		mapVal.SetMapIndex(keyVal.Elem(), reflectTrueValue)
+		mapVal.SetMapIndex(keyVal.Elem(), elemVal)
 	}
 	return nil
 }
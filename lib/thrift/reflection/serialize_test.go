@@ -1,6 +1,7 @@
 package reflection
 
 import (
+	thrift "github.com/jxskiss/thriftkit/lib/thrift"
 	"github.com/matryer/is"
 	"io"
 	"testing"
@@ -59,3 +60,113 @@ func TestCompactMarshalUnmarshal(t *testing.T) {
 	is.True(err == nil || err == io.EOF)
 	is.Equal(obj1, val1)
 }
+
+type userID int64
+type blob []byte
+type tags []string
+
+type DefinedTypesObject struct {
+	ID   userID          `thrift:"id,1"`
+	Data blob            `thrift:"data,2"`
+	Tags tags            `thrift:"tags,3"`
+	Refs map[userID]blob `thrift:"refs,4"`
+}
+
+func TestDefinedTypes(t *testing.T) {
+	is := is.NewRelaxed(t)
+	obj1 := DefinedTypesObject{
+		ID:   1,
+		Data: blob("data"),
+		Tags: tags{"a", "b"},
+		Refs: map[userID]blob{2: blob("ref")},
+	}
+
+	b1, err := Marshal(&obj1)
+	is.NoErr(err)
+
+	// defined types are encoded as their underlying types
+	b2, err := Marshal(&struct {
+		ID   int64            `thrift:"id,1"`
+		Data []byte           `thrift:"data,2"`
+		Tags []string         `thrift:"tags,3"`
+		Refs map[int64][]byte `thrift:"refs,4"`
+	}{1, []byte("data"), []string{"a", "b"}, map[int64][]byte{2: []byte("ref")}})
+	is.NoErr(err)
+	is.Equal(b1, b2)
+
+	var val1 DefinedTypesObject
+	err = Unmarshal(b1, &val1)
+	is.True(err == nil || err == io.EOF)
+	is.Equal(obj1, val1)
+}
+
+type point struct {
+	X int32 `thrift:"x,1"`
+	Y int32 `thrift:"y,2"`
+}
+
+type SetsObject struct {
+	IDs    []int64             `thrift:"ids,1,,set"`
+	Names  map[string]struct{} `thrift:"names,2,,set"`
+	Points map[point]string    `thrift:"points,3,,map"`
+}
+
+func TestSets(t *testing.T) {
+	is := is.NewRelaxed(t)
+	obj1 := SetsObject{
+		IDs:   []int64{3, 1, 2},
+		Names: map[string]struct{}{"a": {}},
+	}
+
+	b1, err := Marshal(&obj1)
+	is.NoErr(err)
+
+	// sets as slices and maps of empty struct are encoded as sets
+	b2, err := Marshal(&struct {
+		IDs   []int64         `thrift:"ids,1,,set"`
+		Names map[string]bool `thrift:"names,2,,set"`
+	}{[]int64{3, 1, 2}, map[string]bool{"a": true}})
+	is.NoErr(err)
+	is.Equal(b1, b2)
+
+	// structs as map keys by value
+	obj1.Points = map[point]string{{1, 2}: "p", {3, 4}: "q"}
+	b1, err = Marshal(&obj1)
+	is.NoErr(err)
+
+	var val1 SetsObject
+	err = Unmarshal(b1, &val1)
+	is.True(err == nil || err == io.EOF)
+	is.Equal(obj1, val1)
+}
+
+type UnknownObject struct {
+	A       string               `thrift:"a,1"`
+	Unknown thrift.UnknownFields `thrift:",,unknown"`
+}
+
+func TestUnknownFields(t *testing.T) {
+	is := is.NewRelaxed(t)
+	obj1 := TestObject{
+		A: "a",
+		B: 2,
+		C: []int64{3},
+		D: map[int]string{4: "d"},
+	}
+	b1, err := Marshal(&obj1)
+	is.NoErr(err)
+
+	// fields of newer versions are written back verbatim
+	var val1 UnknownObject
+	err = Unmarshal(b1, &val1)
+	is.True(err == nil || err == io.EOF)
+	is.Equal(val1.A, "a")
+	is.Equal(len(val1.Unknown), 3)
+
+	b2, err := Marshal(&val1)
+	is.NoErr(err)
+	var val2 TestObject
+	err = Unmarshal(b2, &val2)
+	is.True(err == nil || err == io.EOF)
+	is.Equal(obj1, val2)
+}
@@ -8,6 +8,10 @@ import (
 type structDecoder struct {
 	fields   []structDecoderField
 	fieldMap map[int16]structDecoderField
+
+	// offset of the field tagged "unknown", which preserves unknown fields
+	unknownOffset uintptr
+	keepUnknown   bool
 }
 
 type structDecoderField struct {
@@ -20,6 +24,9 @@ func (decoder *structDecoder) decode(ptr unsafe.Pointer, r thrift.Reader) error
 	if _, err := r.ReadStructBegin(); err != nil {
 		return err
 	}
+	if decoder.keepUnknown {
+		*(*thrift.UnknownFields)(unsafe.Pointer(uintptr(ptr) + decoder.unknownOffset)) = nil
+	}
 	for _, field := range decoder.fields {
 		_, fieldType, fieldId, err := r.ReadFieldBegin()
 		if err != nil {
@@ -57,6 +64,11 @@ func (decoder *structDecoder) decodeByMap(ptr unsafe.Pointer, r thrift.Reader,
 			if err = field.decoder.decode(unsafe.Pointer(uintptr(ptr)+field.offset), r); err != nil {
 				return err
 			}
+		} else if decoder.keepUnknown {
+			fs := (*thrift.UnknownFields)(unsafe.Pointer(uintptr(ptr) + decoder.unknownOffset))
+			if err := fs.Read(r, fieldType, fieldId); err != nil {
+				return err
+			}
 		} else {
 			if err := r.Skip(fieldType); err != nil {
 				return err
@@ -70,6 +82,10 @@ func (decoder *structDecoder) decodeByMap(ptr unsafe.Pointer, r thrift.Reader,
 
 type structEncoder struct {
 	fields []structEncoderField
+
+	// offset of the field tagged "unknown", which preserves unknown fields
+	unknownOffset uintptr
+	keepUnknown   bool
 }
 
 type structEncoderField struct {
@@ -102,6 +118,12 @@ func (encoder *structEncoder) encode(ptr unsafe.Pointer, w thrift.Writer) error
 			return err
 		}
 	}
+	if encoder.keepUnknown {
+		fs := *(*thrift.UnknownFields)(unsafe.Pointer(uintptr(ptr) + encoder.unknownOffset))
+		if err := fs.Write(w); err != nil {
+			return err
+		}
+	}
 	if err := w.WriteFieldStop(); err != nil {
 		return err
 	}
@@ -8,6 +8,12 @@ import (
 	"unicode"
 )
 
+// isBinaryType tells whether valType is []byte or a defined type of it,
+// which is encoded as binary instead of list.
+func isBinaryType(valType reflect.Type) bool {
+	return valType.Kind() == reflect.Slice && valType.Elem().Kind() == reflect.Uint8
+}
+
 func isEnumType(valType reflect.Type) bool {
 	if valType.Kind() != reflect.Int64 {
 		return false
@@ -35,8 +41,53 @@ func parseFieldId(refField reflect.StructField) int16 {
 	return int16(fieldId)
 }
 
+// isSetElemType tells whether a map with element type valType is
+// considered as a set, i.e. map[T]bool or map[T]struct{}.
+func isSetElemType(valType reflect.Type) bool {
+	switch valType.Kind() {
+	case reflect.Bool:
+		return true
+	case reflect.Struct:
+		return valType.NumField() == 0
+	}
+	return false
+}
+
+// isSetField tells whether a slice field is tagged as set, e.g.
+// `thrift:"ids,1,,set"`.
+func isSetField(refField reflect.StructField) bool {
+	tags := strings.Split(refField.Tag.Get("thrift"), ",")
+	if len(tags) > 2 {
+		for _, tag := range tags[2:] {
+			if strings.TrimSpace(tag) == "set" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var unknownFieldsType = reflect.TypeOf(thrift.UnknownFields(nil))
+
+// isUnknownField tells whether a field of type thrift.UnknownFields is
+// tagged to preserve the unknown fields, e.g. `thrift:",,unknown"`.
+func isUnknownField(refField reflect.StructField) bool {
+	if refField.Type != unknownFieldsType {
+		return false
+	}
+	tags := strings.Split(refField.Tag.Get("thrift"), ",")
+	if len(tags) > 2 {
+		for _, tag := range tags[2:] {
+			if strings.TrimSpace(tag) == "unknown" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseMapType(refField reflect.StructField) thrift.Type {
-	if refField.Type.Elem().Kind() != reflect.Bool {
+	if !isSetElemType(refField.Type.Elem()) {
 		return thrift.MAP
 	}
 	thriftTag := refField.Tag.Get("thrift")
@@ -48,6 +99,6 @@ func parseMapType(refField reflect.StructField) thrift.Type {
 			}
 		}
 	}
-	// By default, consider map with boolean value as SET.
+	// By default, consider map with boolean or empty struct value as SET.
 	return thrift.SET
 }
@@ -2,14 +2,17 @@ package thrift
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"log"
 	"net"
+	"net/http"
 	"runtime"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // ErrServerClosed is returned by the Serve methods after a call to Stop
@@ -27,6 +30,16 @@ type Server struct {
 	ppool    sync.Pool
 	n        int64
 	quit     chan struct{}
+	closing  int32 // atomic
+
+	mu         sync.Mutex
+	onShutdown []func()
+
+	// serves HTTP connections found by transport detection, httpLis and
+	// httpSrv are guarded by mu
+	httpOnce sync.Once
+	httpLis  *connListener
+	httpSrv  *http.Server
 }
 
 func NewServer(p Processor, options ...Option) *Server {
@@ -45,21 +58,35 @@ func NewServer(p Processor, options ...Option) *Server {
 	return s
 }
 
-// Listen returns the Server transport listener
+// Listen returns the Server transport listener.
+// Besides TCP "host:port", addr can also be "unix:///path/to/socket",
+// "unix://@abstract-name" or "fd://3" for a socket-activated listener.
 func (p *Server) Listen(addr string) error {
-	listener, err := net.Listen("tcp", addr)
+	listener, err := listen(addr)
 	if err != nil {
 		return err
 	}
-	p.listener = listener
+	p.UseListener(listener)
 	return nil
 }
 
+// UseListener makes the Server accept connections from an existing
+// listener, e.g. one passed by a process manager.
+func (p *Server) UseListener(listener net.Listener) {
+	if p.opts.tlsConfig != nil {
+		listener = tls.NewListener(listener, p.opts.tlsConfig)
+	}
+	p.listener = listener
+}
+
 // Serve runs the accept loop to handle requests.
 func (p *Server) Serve() error {
 	for {
 		client, err := p.listener.Accept()
 		if err != nil {
+			if atomic.LoadInt32(&p.closing) != 0 {
+				return ErrServerClosed
+			}
 			log.Println("accept error:", err) // TODO
 			continue
 		}
@@ -87,27 +114,113 @@ func (p *Server) ListenAndServe(addr string) error {
 	return p.Serve()
 }
 
+// ServeListener runs the accept loop on the given listener.
+func (p *Server) ServeListener(listener net.Listener) error {
+	p.UseListener(listener)
+	return p.Serve()
+}
+
 // Stop stops the Server.
 func (p *Server) Stop() error {
 	p.quit <- struct{}{}
+	if srv := p.closeHTTP(); srv != nil {
+		srv.Close()
+	}
+	return nil
+}
+
+// RegisterOnShutdown registers a function to be called by Shutdown before
+// the server stops accepting connections, e.g. deregistering the server
+// from service discovery.
+func (p *Server) RegisterOnShutdown(f func()) {
+	p.mu.Lock()
+	p.onShutdown = append(p.onShutdown, f)
+	p.mu.Unlock()
+}
+
+// Shutdown gracefully stops the server. It calls the functions registered
+// by RegisterOnShutdown, then closes the listener and waits for the active
+// connections to be closed until ctx is done.
+func (p *Server) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	funcs := p.onShutdown
+	p.onShutdown = nil
+	p.mu.Unlock()
+	for _, f := range funcs {
+		f()
+	}
+
+	atomic.StoreInt32(&p.closing, 1)
+	if p.listener != nil {
+		p.listener.Close()
+	}
+	if srv := p.closeHTTP(); srv != nil {
+		if err := srv.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for atomic.LoadInt64(&p.n) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 	return nil
 }
 
 func (p *Server) process(client net.Conn) {
+	var handedOff bool
 	defer func() {
 		if err := recover(); err != nil {
 			buf := make([]byte, 64<<10)
 			buf = buf[:runtime.Stack(buf, false)]
 			log.Printf("server: panic serving %s: %v\n%s\n", client.RemoteAddr(), err, buf)
 		}
-		client.Close() // potential errors ignored
+		if !handedOff {
+			client.Close() // potential errors ignored
+		}
 		atomic.AddInt64(&p.n, -1)
 	}()
+
+	ctx := context.Background()
+	_, isTLS := client.(*tls.Conn)
+	timeout := p.opts.handshakeTimeout
+	if timeout > 0 && (isTLS || p.opts.detect) {
+		// limits the handshake and detection, it is cleared after them
+		_ = client.SetDeadline(time.Now().Add(timeout)) // shall not fail
+	}
+	if tlsConn, ok := client.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			log.Printf("server: tls handshake with client %s error: %s\n", client.RemoteAddr(), err)
+			return
+		}
+		if peer := newTLSPeer(tlsConn.ConnectionState()); peer != nil {
+			ctx = context.WithValue(ctx, tlsPeerCtxKey{}, peer)
+		}
+	}
+	var isHTTP bool
+	if p.opts.detect {
+		clientType, conn, err := sniffConn(client)
+		if err != nil {
+			return
+		}
+		client, isHTTP = conn, clientType == HTTPServerType
+	}
+	if timeout > 0 && (isTLS || p.opts.detect) {
+		_ = client.SetDeadline(time.Time{})
+	}
+	if isHTTP {
+		handedOff = p.processHttp(client)
+		return
+	}
+
 	prot := p.ppool.Get().(*Protocol)
 	defer p.ppool.Put(prot)
 
 	prot.Reset(client)
-	ctx := context.Background()
 	ctx = context.WithValue(ctx, protocolCtxKey{}, prot)
 	ctx = context.WithValue(ctx, remoteAddrCtxKey{}, client.RemoteAddr().String())
 	if err := p.processor.Process(ctx, prot, prot); err != nil {
@@ -117,9 +230,20 @@ func (p *Server) process(client net.Conn) {
 	}
 }
 
+// processHttp hands off an HTTP connection to the configured http.Handler,
+// it reports whether the connection is taken over by the http server.
+func (p *Server) processHttp(client net.Conn) bool {
+	if p.opts.httpHandler == nil {
+		log.Printf("server: no http handler for client %s\n", client.RemoteAddr())
+		return false
+	}
+	return p.httpServer().serve(client) == nil
+}
+
 type (
 	protocolCtxKey   struct{}
 	remoteAddrCtxKey struct{}
+	tlsPeerCtxKey    struct{}
 )
 
 func ProtocolFromCtx(ctx context.Context) *Protocol {
@@ -136,6 +260,16 @@ func RemoteAddrFromCtx(ctx context.Context) string {
 	return ""
 }
 
+// TLSPeerFromCtx returns the identity of the client proved by a verified
+// TLS certificate, it returns nil if the client is not authenticated by
+// mutual TLS.
+func TLSPeerFromCtx(ctx context.Context) *TLSPeer {
+	if p, ok := ctx.Value(tlsPeerCtxKey{}).(*TLSPeer); ok {
+		return p
+	}
+	return nil
+}
+
 func isForciblyClosed(err error) bool {
 	if e, ok := err.(*net.OpError); ok {
 		return strings.Contains(e.Err.Error(), "forcibly closed")
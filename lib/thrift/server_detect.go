@@ -0,0 +1,118 @@
+package thrift
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+)
+
+var errListenerClosed = errors.New("thrift: listener closed")
+
+// sniffConn reads the first word from a newly accepted connection and
+// guesses the client type from it. The returned connection replays the
+// consumed bytes, so it can be used as if nothing has been read.
+//
+// UnknownClientType means the client is talking a framed protocol, the
+// header transport will figure out the concrete one from the frame.
+func sniffConn(conn net.Conn) (ClientType, net.Conn, error) {
+	var word [4]byte
+	n, err := io.ReadFull(conn, word[:])
+	if err != nil {
+		return UnknownClientType, nil, err
+	}
+	sc := &sniffedConn{Conn: conn, buf: word[:n]}
+	return analyzeFirst32Bit(binary.BigEndian.Uint32(word[:])), sc, nil
+}
+
+// sniffedConn is a net.Conn which returns the bytes consumed while
+// sniffing before reading from the underlying connection.
+type sniffedConn struct {
+	net.Conn
+	buf []byte
+}
+
+func (c *sniffedConn) Read(b []byte) (int, error) {
+	if len(c.buf) > 0 {
+		n := copy(b, c.buf)
+		c.buf = c.buf[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
+
+// connListener implements net.Listener to feed the HTTP connections
+// detected by Server into a standard http.Server.
+type connListener struct {
+	addr  net.Addr
+	conns chan net.Conn
+
+	once sync.Once
+	done chan struct{}
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:  addr,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+func (l *connListener) serve(conn net.Conn) error {
+	select {
+	case l.conns <- conn:
+		return nil
+	case <-l.done:
+		return errListenerClosed
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, errListenerClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
+
+// httpServer returns the HTTP server to serve detected HTTP connections,
+// it is started at the first call.
+func (p *Server) httpServer() *connListener {
+	p.httpOnce.Do(func() {
+		lis := newConnListener(p.listener.Addr())
+		srv := &http.Server{Handler: p.opts.httpHandler}
+		p.mu.Lock()
+		p.httpLis, p.httpSrv = lis, srv
+		p.mu.Unlock()
+		go srv.Serve(lis)
+	})
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.httpLis
+}
+
+// closeHTTP closes the listener of detected HTTP connections, it returns
+// the HTTP server to be closed or shut down, which is nil if it has not
+// been started.
+func (p *Server) closeHTTP() *http.Server {
+	p.mu.Lock()
+	lis, srv := p.httpLis, p.httpSrv
+	p.mu.Unlock()
+	if lis != nil {
+		lis.Close()
+	}
+	return srv
+}
@@ -0,0 +1,207 @@
+package thrift
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+type echoStruct struct {
+	S string
+}
+
+func (p *echoStruct) Read(r Reader) (err error) {
+	if _, err = r.ReadStructBegin(); err != nil {
+		return err
+	}
+	for {
+		_, fieldType, fieldId, err := r.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if fieldType == STOP {
+			break
+		}
+		if fieldId == 1 && fieldType == STRING {
+			if p.S, err = r.ReadString(); err != nil {
+				return err
+			}
+		} else if err = r.Skip(fieldType); err != nil {
+			return err
+		}
+	}
+	return r.ReadStructEnd()
+}
+
+func (p *echoStruct) Write(w Writer) (err error) {
+	if err = w.WriteStructBegin("echoStruct"); err != nil {
+		return err
+	}
+	if err = w.WriteFieldBegin("S", STRING, 1); err != nil {
+		return err
+	}
+	if err = w.WriteString(p.S); err != nil {
+		return err
+	}
+	if err = w.WriteFieldStop(); err != nil {
+		return err
+	}
+	return w.WriteStructEnd()
+}
+
+// echoProcessor replies every call with the received arguments.
+type echoProcessor struct{}
+
+func (echoProcessor) Process(ctx context.Context, r Reader, w Writer) error {
+	for {
+		name, _, seqid, err := r.ReadMessageBegin()
+		if err != nil {
+			return err
+		}
+		var args echoStruct
+		if err = args.Read(r); err != nil {
+			return err
+		}
+		if err = w.WriteMessageBegin(name, REPLY, seqid); err != nil {
+			return err
+		}
+		if err = args.Write(w); err != nil {
+			return err
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func TestServerTransportDetection(t *testing.T) {
+	is := is.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello http"))
+	})
+	server := NewServer(echoProcessor{}, WithTransportDetection(handler))
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+	addr := server.listener.Addr().String()
+
+	clientOpts := map[string][]Option{
+		"unframed binary":  nil,
+		"unframed compact": {WithCompact()},
+		"framed binary":    {WithFramed(1 << 20)},
+		"framed compact":   {WithFramed(1 << 20), WithCompact()},
+		"header binary":    {WithHeader()},
+		"header compact":   {WithHeader(), WithCompact()},
+	}
+	for name, opts := range clientOpts {
+		cli := NewClient(StdDialer, addr, opts...)
+		arg, ret := &echoStruct{S: name}, &echoStruct{}
+		err := cli.Invoke(context.Background(), "Echo", arg, ret)
+		is.NoErr(err)
+		is.Equal(ret.S, name)
+		cli.Close()
+	}
+
+	rsp, err := http.Post("http://"+addr, "text/plain", strings.NewReader("dummy"))
+	is.NoErr(err)
+	body, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	is.NoErr(err)
+	is.Equal(string(body), "hello http")
+}
+
+func TestServerUnixSocket(t *testing.T) {
+	is := is.New(t)
+
+	dir, err := ioutil.TempDir("", "thrift-unix")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	addr := "unix://" + filepath.Join(dir, "echo.sock")
+
+	server := NewServer(echoProcessor{})
+	is.NoErr(server.Listen(addr))
+	go server.Serve()
+	defer server.Stop()
+
+	cli := NewClient(StdDialer, addr)
+	ret := &echoStruct{}
+	is.NoErr(cli.Invoke(context.Background(), "Echo", &echoStruct{S: "unix"}, ret))
+	is.Equal(ret.S, "unix")
+	cli.Close()
+
+	is.Equal(peerKey("127.0.0.1:9090"), peerKey("tcp://127.0.0.1:9090"))
+}
+
+func TestServerDetectionTimeout(t *testing.T) {
+	is := is.New(t)
+
+	server := NewServer(echoProcessor{}, WithTransportDetection(nil), WithHandshakeTimeout(20*time.Millisecond))
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+
+	// the silent client is disconnected
+	conn, err := net.Dial("tcp", server.listener.Addr().String())
+	is.NoErr(err)
+	defer conn.Close()
+	is.NoErr(conn.SetReadDeadline(time.Now().Add(2 * time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	is.Equal(err, io.EOF)
+}
+
+func TestServerCloseDetectedHTTP(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello http"))
+	})
+	stops := map[string]func(s *Server) error{
+		"stop":     (*Server).Stop,
+		"shutdown": func(s *Server) error { return s.Shutdown(context.Background()) },
+	}
+	for name, stop := range stops {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+			server := NewServer(echoProcessor{}, WithTransportDetection(handler),
+				WithHandshakeTimeout(20*time.Millisecond))
+			is.NoErr(server.Listen("127.0.0.1:0"))
+			go server.Serve()
+
+			conn, err := net.Dial("tcp", server.listener.Addr().String())
+			is.NoErr(err)
+			defer conn.Close()
+			_, err = conn.Write([]byte("GET / HTTP/1.1\r\nHost: test\r\n\r\n"))
+			is.NoErr(err)
+			br := bufio.NewReader(conn)
+			rsp, err := http.ReadResponse(br, nil)
+			is.NoErr(err)
+			body, err := ioutil.ReadAll(rsp.Body)
+			is.NoErr(err)
+			is.Equal(string(body), "hello http")
+
+			// the handshake deadline is cleared for the kept-alive connection
+			time.Sleep(50 * time.Millisecond)
+			_, err = conn.Write([]byte("GET / HTTP/1.1\r\nHost: test\r\n\r\n"))
+			is.NoErr(err)
+			rsp, err = http.ReadResponse(br, nil)
+			is.NoErr(err)
+			_, err = ioutil.ReadAll(rsp.Body)
+			is.NoErr(err)
+
+			// the idle connection is closed by the http server
+			is.NoErr(stop(server))
+			is.NoErr(conn.SetReadDeadline(time.Now().Add(2 * time.Second)))
+			_, err = br.ReadByte()
+			is.Equal(err, io.EOF)
+		})
+	}
+}
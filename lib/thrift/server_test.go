@@ -0,0 +1,29 @@
+package thrift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestServerShutdown(t *testing.T) {
+	is := is.New(t)
+
+	server := NewServer(echoProcessor{})
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	served := make(chan error, 1)
+	go func() { served <- server.Serve() }()
+	addr := server.listener.Addr().String()
+
+	cli := NewClient(StdDialer, addr)
+	ret := &echoStruct{}
+	is.NoErr(cli.Invoke(context.Background(), "Echo", &echoStruct{S: "a"}, ret))
+	cli.Close()
+
+	var hooked bool
+	server.RegisterOnShutdown(func() { hooked = true })
+	is.NoErr(server.Shutdown(context.Background()))
+	is.True(hooked)
+	is.Equal(<-served, ErrServerClosed)
+}
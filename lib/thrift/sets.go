@@ -0,0 +1,341 @@
+package thrift
+
+// Typed sets of the base types, which are used by the generated code to
+// represent thrift sets when the generator option "-set-type=typed" is
+// given. Sets of enums and structs are generated along with the types.
+
+// BoolSet is a set of bool.
+type BoolSet map[bool]struct{}
+
+// NewBoolSet returns a BoolSet containing elems.
+func NewBoolSet(elems ...bool) BoolSet {
+	s := make(BoolSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s BoolSet) Add(elems ...bool) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s BoolSet) Remove(elems ...bool) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s BoolSet) Contains(e bool) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s BoolSet) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s BoolSet) Slice() []bool {
+	r := make([]bool, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Int8Set is a set of int8.
+type Int8Set map[int8]struct{}
+
+// NewInt8Set returns a Int8Set containing elems.
+func NewInt8Set(elems ...int8) Int8Set {
+	s := make(Int8Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Int8Set) Add(elems ...int8) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Int8Set) Remove(elems ...int8) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Int8Set) Contains(e int8) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Int8Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Int8Set) Slice() []int8 {
+	r := make([]int8, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Int16Set is a set of int16.
+type Int16Set map[int16]struct{}
+
+// NewInt16Set returns a Int16Set containing elems.
+func NewInt16Set(elems ...int16) Int16Set {
+	s := make(Int16Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Int16Set) Add(elems ...int16) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Int16Set) Remove(elems ...int16) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Int16Set) Contains(e int16) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Int16Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Int16Set) Slice() []int16 {
+	r := make([]int16, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Int32Set is a set of int32.
+type Int32Set map[int32]struct{}
+
+// NewInt32Set returns a Int32Set containing elems.
+func NewInt32Set(elems ...int32) Int32Set {
+	s := make(Int32Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Int32Set) Add(elems ...int32) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Int32Set) Remove(elems ...int32) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Int32Set) Contains(e int32) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Int32Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Int32Set) Slice() []int32 {
+	r := make([]int32, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Int64Set is a set of int64.
+type Int64Set map[int64]struct{}
+
+// NewInt64Set returns a Int64Set containing elems.
+func NewInt64Set(elems ...int64) Int64Set {
+	s := make(Int64Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Int64Set) Add(elems ...int64) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Int64Set) Remove(elems ...int64) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Int64Set) Contains(e int64) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Int64Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Int64Set) Slice() []int64 {
+	r := make([]int64, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Float32Set is a set of float32.
+type Float32Set map[float32]struct{}
+
+// NewFloat32Set returns a Float32Set containing elems.
+func NewFloat32Set(elems ...float32) Float32Set {
+	s := make(Float32Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Float32Set) Add(elems ...float32) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Float32Set) Remove(elems ...float32) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Float32Set) Contains(e float32) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Float32Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Float32Set) Slice() []float32 {
+	r := make([]float32, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// Float64Set is a set of float64.
+type Float64Set map[float64]struct{}
+
+// NewFloat64Set returns a Float64Set containing elems.
+func NewFloat64Set(elems ...float64) Float64Set {
+	s := make(Float64Set, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s Float64Set) Add(elems ...float64) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s Float64Set) Remove(elems ...float64) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s Float64Set) Contains(e float64) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Float64Set) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s Float64Set) Slice() []float64 {
+	r := make([]float64, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
+
+// StringSet is a set of string.
+type StringSet map[string]struct{}
+
+// NewStringSet returns a StringSet containing elems.
+func NewStringSet(elems ...string) StringSet {
+	s := make(StringSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds elems to the set.
+func (s StringSet) Add(elems ...string) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes elems from the set.
+func (s StringSet) Remove(elems ...string) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains tells whether e is in the set.
+func (s StringSet) Contains(e string) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s StringSet) Len() int { return len(s) }
+
+// Slice returns the elements of the set in unspecified order.
+func (s StringSet) Slice() []string {
+	r := make([]string, 0, len(s))
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
@@ -0,0 +1,95 @@
+package thrift
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestStringSet(t *testing.T) {
+	is := is.New(t)
+
+	s := NewStringSet("a", "b", "a")
+	is.Equal(s.Len(), 2)
+	is.True(s.Contains("a"))
+	is.True(!s.Contains("c"))
+
+	s.Add("c")
+	s.Remove("a", "x")
+	elems := s.Slice()
+	sort.Strings(elems)
+	is.Equal(elems, []string{"b", "c"})
+}
+
+func TestBoolSet(t *testing.T) {
+	is := is.New(t)
+
+	s := NewBoolSet(true, true)
+	is.Equal(s.Len(), 1)
+	is.True(s.Contains(true))
+	is.True(!s.Contains(false))
+
+	s.Add(false)
+	is.Equal(s.Len(), 2)
+	s.Remove(true)
+	is.Equal(s.Slice(), []bool{false})
+}
+
+func TestNumericSets(t *testing.T) {
+	is := is.New(t)
+
+	i8 := NewInt8Set(1, 2, 1)
+	i8.Add(-3)
+	i8.Remove(1, 4)
+	is.Equal(i8.Len(), 2)
+	is.True(i8.Contains(-3) && !i8.Contains(1))
+	e8 := i8.Slice()
+	sort.Slice(e8, func(i, j int) bool { return e8[i] < e8[j] })
+	is.Equal(e8, []int8{-3, 2})
+
+	i16 := NewInt16Set(1, 2, 1)
+	i16.Add(-3)
+	i16.Remove(1, 4)
+	is.Equal(i16.Len(), 2)
+	is.True(i16.Contains(-3) && !i16.Contains(1))
+	e16 := i16.Slice()
+	sort.Slice(e16, func(i, j int) bool { return e16[i] < e16[j] })
+	is.Equal(e16, []int16{-3, 2})
+
+	i32 := NewInt32Set(1, 2, 1)
+	i32.Add(-3)
+	i32.Remove(1, 4)
+	is.Equal(i32.Len(), 2)
+	is.True(i32.Contains(-3) && !i32.Contains(1))
+	e32 := i32.Slice()
+	sort.Slice(e32, func(i, j int) bool { return e32[i] < e32[j] })
+	is.Equal(e32, []int32{-3, 2})
+
+	i64 := NewInt64Set(1, 2, 1)
+	i64.Add(-3)
+	i64.Remove(1, 4)
+	is.Equal(i64.Len(), 2)
+	is.True(i64.Contains(-3) && !i64.Contains(1))
+	e64 := i64.Slice()
+	sort.Slice(e64, func(i, j int) bool { return e64[i] < e64[j] })
+	is.Equal(e64, []int64{-3, 2})
+
+	f32 := NewFloat32Set(0.5, 1.5, 0.5)
+	f32.Add(-2)
+	f32.Remove(0.5, 4)
+	is.Equal(f32.Len(), 2)
+	is.True(f32.Contains(-2) && !f32.Contains(0.5))
+	ef32 := f32.Slice()
+	sort.Slice(ef32, func(i, j int) bool { return ef32[i] < ef32[j] })
+	is.Equal(ef32, []float32{-2, 1.5})
+
+	f64 := NewFloat64Set(0.5, 1.5, 0.5)
+	f64.Add(-2)
+	f64.Remove(0.5, 4)
+	is.Equal(f64.Len(), 2)
+	is.True(f64.Contains(-2) && !f64.Contains(0.5))
+	ef64 := f64.Slice()
+	sort.Float64s(ef64)
+	is.Equal(ef64, []float64{-2, 1.5})
+}
@@ -0,0 +1,137 @@
+package thrift
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// TLSPeer holds the identity of a client proved by a verified TLS
+// certificate.
+type TLSPeer struct {
+	Subject pkix.Name
+
+	// subject alternative names
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+
+	// Certificate is the verified leaf certificate of the client.
+	Certificate *x509.Certificate
+}
+
+// newTLSPeer returns nil if the client did not present a certificate
+// or the certificate is not verified.
+func newTLSPeer(state tls.ConnectionState) *TLSPeer {
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	cert := state.VerifiedChains[0][0]
+	return &TLSPeer{
+		Subject:        cert.Subject,
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		IPAddresses:    cert.IPAddresses,
+		URIs:           cert.URIs,
+		Certificate:    cert,
+	}
+}
+
+// CertReloader loads a certificate key pair from PEM files, and reloads
+// it once the files have been changed. The files are checked at most once
+// per interval when a certificate is requested during handshake.
+//
+// Use GetCertificate for tls.Config.GetCertificate on the server side,
+// and GetClientCertificate for tls.Config.GetClientCertificate on the
+// client side.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func NewCertReloader(certFile, keyFile string, interval time.Duration) (*CertReloader, error) {
+	r := &CertReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificate key pair from files unconditionally.
+func (r *CertReloader) Reload() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTime = modTime
+	r.checkedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+func (r *CertReloader) certificate() (*tls.Certificate, error) {
+	r.mu.RLock()
+	cert, modTime, checkedAt := r.cert, r.modTime, r.checkedAt
+	r.mu.RUnlock()
+	if time.Since(checkedAt) < r.interval {
+		return cert, nil
+	}
+
+	r.mu.Lock()
+	r.checkedAt = time.Now()
+	r.mu.Unlock()
+	// Keep serving the old certificate if the new one is not available,
+	// the files may be in the middle of being replaced.
+	if latest, err := r.lastModified(); err == nil && latest.After(modTime) {
+		if err = r.Reload(); err == nil {
+			r.mu.RLock()
+			cert = r.cert
+			r.mu.RUnlock()
+		}
+	}
+	return cert, nil
+}
+
+func (r *CertReloader) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, fn := range []string{r.certFile, r.keyFile} {
+		fi, err := os.Stat(fn)
+		if err != nil {
+			return latest, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,189 @@
+package thrift
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	cert, err := tls.X509KeyPair(c.certPEM, c.keyPEM)
+	if err != nil {
+		panic(err)
+	}
+	return cert
+}
+
+func newTestCert(tmpl *x509.Certificate, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		panic(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// peerProcessor replies every call with the common name of the client.
+type peerProcessor struct{}
+
+func (peerProcessor) Process(ctx context.Context, r Reader, w Writer) error {
+	for {
+		name, _, seqid, err := r.ReadMessageBegin()
+		if err != nil {
+			return err
+		}
+		var args echoStruct
+		if err = args.Read(r); err != nil {
+			return err
+		}
+		if peer := TLSPeerFromCtx(ctx); peer != nil {
+			args.S = peer.Subject.CommonName + " " + peer.URIs[0].String()
+		} else {
+			args.S = "anonymous"
+		}
+		if err = w.WriteMessageBegin(name, REPLY, seqid); err != nil {
+			return err
+		}
+		if err = args.Write(w); err != nil {
+			return err
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	is := is.New(t)
+
+	ca := newTestCert(&x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	serverCert := newTestCert(&x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "server"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	spiffeID, _ := url.Parse("spiffe://example.org/svc/client")
+	clientCert := newTestCert(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		URIs:         []*url.URL{spiffeID},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	serverCfg := &tls.Config{Certificates: []tls.Certificate{serverCert.tlsCertificate()}}
+	server := NewServer(peerProcessor{}, WithMutualTLS(serverCfg, pool))
+	is.NoErr(server.Listen("127.0.0.1:0"))
+	go server.Serve()
+	defer server.Stop()
+	addr := server.listener.Addr().String()
+
+	clientCfg := &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{clientCert.tlsCertificate()},
+	}
+	cli := NewClient(NewTlsDialer(clientCfg), addr)
+	ret := &echoStruct{}
+	is.NoErr(cli.Invoke(context.Background(), "Who", &echoStruct{}, ret))
+	is.Equal(ret.S, "client spiffe://example.org/svc/client")
+	cli.Close()
+
+	// clients without certificate are rejected
+	anonymous := NewClient(NewTlsDialer(&tls.Config{RootCAs: pool}), addr)
+	err := anonymous.Invoke(context.Background(), "Who", &echoStruct{}, &echoStruct{})
+	is.True(err != nil)
+	anonymous.Close()
+}
+
+func TestCertReloader(t *testing.T) {
+	is := is.New(t)
+
+	dir, err := ioutil.TempDir("", "thrift-tls")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCert := func(c *testCert, modTime time.Time) {
+		is.NoErr(ioutil.WriteFile(certFile, c.certPEM, 0600))
+		is.NoErr(ioutil.WriteFile(keyFile, c.keyPEM, 0600))
+		is.NoErr(os.Chtimes(certFile, modTime, modTime))
+		is.NoErr(os.Chtimes(keyFile, modTime, modTime))
+	}
+
+	cert1 := newTestCert(&x509.Certificate{SerialNumber: big.NewInt(1)}, nil)
+	cert2 := newTestCert(&x509.Certificate{SerialNumber: big.NewInt(2)}, nil)
+	now := time.Now()
+	writeCert(cert1, now.Add(-time.Minute))
+
+	reloader, err := NewCertReloader(certFile, keyFile, 0)
+	is.NoErr(err)
+	got, err := reloader.GetCertificate(nil)
+	is.NoErr(err)
+	is.Equal(got.Certificate[0], cert1.cert.Raw)
+
+	writeCert(cert2, now)
+	got, err = reloader.GetCertificate(nil)
+	is.NoErr(err)
+	is.Equal(got.Certificate[0], cert2.cert.Raw)
+
+	// a broken pair keeps the last good certificate
+	is.NoErr(ioutil.WriteFile(keyFile, []byte("broken"), 0600))
+	is.NoErr(os.Chtimes(keyFile, now.Add(time.Minute), now.Add(time.Minute)))
+	got, err = reloader.GetClientCertificate(nil)
+	is.NoErr(err)
+	is.Equal(got.Certificate[0], cert2.cert.Raw)
+}
+
+func TestMutualTLSNilConfig(t *testing.T) {
+	is := is.New(t)
+	pool := x509.NewCertPool()
+	o := WithMutualTLS(nil, pool)(DefaultOptions)
+	is.True(o.tlsConfig != nil)
+	is.Equal(o.tlsConfig.ClientAuth, tls.RequireAndVerifyClientCert)
+	is.Equal(o.tlsConfig.ClientCAs, pool)
+}
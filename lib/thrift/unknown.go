@@ -0,0 +1,111 @@
+package thrift
+
+import (
+	"fmt"
+)
+
+// UnknownField is a field read from the wire which is not defined in the
+// struct, e.g. a field added by a newer version of the IDL.
+// Raw is the encoded value of the field in protocol Proto, except that
+// bool values are always kept as a single byte 0 or 1, since the compact
+// protocol encodes them in the field header.
+type UnknownField struct {
+	ID    int16
+	Type  Type
+	Proto ProtocolID
+	Raw   []byte
+}
+
+// UnknownFields preserves the unknown fields of a struct, so that messages
+// of newer versions can be decoded and encoded again without losing data.
+// They are written back verbatim after the known fields.
+type UnknownFields []UnknownField
+
+// Read reads the value of an unknown field whose header has been read,
+// and appends it to fs.
+func (fs *UnknownFields) Read(r Reader, fieldType Type, id int16) error {
+	f := UnknownField{ID: id, Type: fieldType, Proto: protocolOf(r)}
+	if fieldType == BOOL {
+		v, err := r.ReadBool()
+		if err != nil {
+			return err
+		}
+		f.Raw = []byte{0}
+		if v {
+			f.Raw[0] = 1
+		}
+	} else {
+		raw, err := r.ReadRaw(fieldType)
+		if err != nil {
+			return err
+		}
+		f.Raw = raw
+	}
+	*fs = append(*fs, f)
+	return nil
+}
+
+// Write writes the unknown fields to w, which must use the protocol in
+// which the fields were read.
+func (fs UnknownFields) Write(w Writer) error {
+	proto := protocolOf(w)
+	rw, isRaw := rawWriterOf(w)
+	for _, f := range fs {
+		if f.Type != BOOL && f.Proto != proto {
+			return fmt.Errorf("thrift: unknown field %d was read in protocol %#x, cannot write in %#x", f.ID, f.Proto, proto)
+		}
+		if f.Type != BOOL && !isRaw {
+			return fmt.Errorf("thrift: unknown field %d cannot be written by %T, which is not a RawWriter", f.ID, w)
+		}
+		if err := w.WriteFieldBegin("", f.Type, f.ID); err != nil {
+			return err
+		}
+		var err error
+		if f.Type == BOOL {
+			err = w.WriteBool(len(f.Raw) > 0 && f.Raw[0] != 0)
+		} else {
+			err = rw.WriteRaw(f.Raw)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of fs which shares no memory with fs.
+func (fs UnknownFields) DeepCopy() UnknownFields {
+	if fs == nil {
+		return nil
+	}
+	c := make(UnknownFields, len(fs))
+	for i, f := range fs {
+		f.Raw = append([]byte{}, f.Raw...)
+		c[i] = f
+	}
+	return c
+}
+
+// rawWriterOf returns w, or the Writer of w if it is a Protocol, as a
+// RawWriter.
+func rawWriterOf(w Writer) (RawWriter, bool) {
+	if p, ok := w.(*Protocol); ok {
+		w = p.Writer
+	}
+	rw, ok := w.(RawWriter)
+	return rw, ok
+}
+
+// protocolOf returns the protocol which the reader or writer x uses.
+func protocolOf(x interface{}) ProtocolID {
+	switch x := x.(type) {
+	case *Protocol:
+		if _, ok := x.Writer.(*compactWriter); ok {
+			return ProtocolIDCompact
+		}
+		return ProtocolIDBinary
+	case *compactReader, *compactWriter:
+		return ProtocolIDCompact
+	}
+	return ProtocolIDBinary
+}
@@ -0,0 +1,110 @@
+package thrift
+
+import (
+	"bytes"
+	"github.com/matryer/is"
+	"testing"
+)
+
+func writeUnknownTestStruct(w Writer) {
+	w.WriteStructBegin("")
+	w.WriteFieldBegin("", I32, 1)
+	w.WriteI32(1)
+	w.WriteFieldBegin("", BOOL, 2)
+	w.WriteBool(true)
+	w.WriteFieldBegin("", STRUCT, 3)
+	w.WriteStructBegin("")
+	w.WriteFieldBegin("", STRING, 1)
+	w.WriteString("31")
+	w.WriteFieldBegin("", BOOL, 2)
+	w.WriteBool(false)
+	w.WriteFieldStop()
+	w.WriteStructEnd()
+	w.WriteFieldBegin("", LIST, 4)
+	w.WriteListBegin(I64, 2)
+	w.WriteI64(41)
+	w.WriteI64(42)
+	w.WriteFieldStop()
+	w.WriteStructEnd()
+}
+
+func TestUnknownFields(t *testing.T) {
+	for _, opts := range []options{DefaultOptions, WithCompact()(DefaultOptions)} {
+		is := is.New(t)
+
+		var buf bytes.Buffer
+		var p = NewProtocol(nil, opts)
+		p.Reset(&buf)
+
+		writeUnknownTestStruct(p)
+		is.NoErr(p.Flush())
+		rawBytes := append(([]byte)(nil), buf.Bytes()...)
+
+		var known int32
+		var fs UnknownFields
+		_, err := p.ReadStructBegin()
+		is.NoErr(err)
+		for {
+			_, tp, id, err := p.ReadFieldBegin()
+			is.NoErr(err)
+			if tp == STOP {
+				break
+			}
+			if id == 1 {
+				known, err = p.ReadI32()
+			} else {
+				err = fs.Read(p, tp, id)
+			}
+			is.NoErr(err)
+		}
+		is.NoErr(p.ReadStructEnd())
+		is.Equal(known, int32(1))
+		is.Equal(len(fs), 3)
+		is.Equal(fs[0].Raw, []byte{1})
+		is.Equal(buf.Len(), 0)
+
+		// written back verbatim
+		fs = fs.DeepCopy()
+		p.WriteStructBegin("")
+		p.WriteFieldBegin("", I32, 1)
+		p.WriteI32(known)
+		is.NoErr(fs.Write(p))
+		p.WriteFieldStop()
+		p.WriteStructEnd()
+		is.NoErr(p.Flush())
+		is.Equal(buf.Bytes(), rawBytes)
+	}
+}
+
+func TestUnknownFieldsProtocol(t *testing.T) {
+	is := is.New(t)
+
+	var buf bytes.Buffer
+	var p = NewProtocol(nil, DefaultOptions)
+	p.Reset(&buf)
+
+	fs := UnknownFields{{ID: 2, Type: STRING, Proto: ProtocolIDBinary, Raw: []byte{0, 0, 0, 1, 'a'}}}
+	is.NoErr(fs.Write(p))
+	is.NoErr(p.UseCompact(COMPACT_VERSION_BE))
+	is.True(fs.Write(p) != nil)
+}
+
+// plainWriter hides the WriteRaw method of the embedded Writer.
+type plainWriter struct {
+	Writer
+}
+
+func TestUnknownFieldsRawWriter(t *testing.T) {
+	is := is.New(t)
+
+	var buf bytes.Buffer
+	var p = NewProtocol(nil, DefaultOptions)
+	p.Reset(&buf)
+
+	// bool fields are written by WriteBool, others require a RawWriter
+	fs := UnknownFields{{ID: 1, Type: BOOL, Raw: []byte{1}}}
+	is.NoErr(fs.Write(plainWriter{p}))
+	fs = append(fs, UnknownField{ID: 2, Type: STRING, Proto: ProtocolIDBinary, Raw: []byte{0, 0, 0, 1, 'a'}})
+	is.True(fs.Write(plainWriter{p}) != nil)
+	is.NoErr(fs.Write(p))
+}
@@ -0,0 +1,30 @@
+package thrift
+
+import (
+	"regexp"
+	"sync"
+)
+
+// ValidationError is returned by the generated Validate methods when a
+// field violates the rules annotated in IDL.
+type ValidationError struct {
+	Field  string // e.g. "Item.name"
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return "thrift: invalid " + e.Field + ": " + e.Reason
+}
+
+var patterns sync.Map // pattern -> *regexp.Regexp
+
+// MatchPattern tells whether s matches the regular expression pattern,
+// the compiled expressions are cached. It is intended to be used by the
+// generated code, pattern must be valid.
+func MatchPattern(pattern, s string) bool {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	return re.(*regexp.Regexp).MatchString(s)
+}
@@ -0,0 +1,18 @@
+package thrift
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestMatchPattern(t *testing.T) {
+	is := is.New(t)
+
+	is.True(MatchPattern(`^[a-z]+$`, "abc"))
+	is.True(!MatchPattern(`^[a-z]+$`, "abc1"))
+	is.True(MatchPattern(`^[a-z]+$`, "xyz")) // cached
+
+	err := &ValidationError{Field: "Item.name", Reason: "length must be at least 3"}
+	is.Equal(err.Error(), "thrift: invalid Item.name: length must be at least 3")
+}
@@ -18,6 +18,9 @@ func main() {
 	output := flags.String("output", "gen-thrifter", "the root output path for generated files")
 	genAll := flags.Bool("all", false, "also generate all included thrift files")
 	isDebugMode := flags.Bool("debug", false, "enable debug mode for generator")
+	definedTypedefs := flags.Bool("defined-typedefs", false, "generate typedefs as defined types instead of type aliases")
+	setType := flags.String("set-type", generator.SetMap, "Go representation of sets: map, slice or typed")
+	keepUnknown := flags.Bool("keep-unknown", false, "preserve unknown fields when reading structs and write them back")
 	flags.Parse(os.Args[1:])
 
 	if filepath.Base(*prefix) != filepath.Base(*output) {
@@ -38,6 +41,13 @@ func main() {
 	if *isDebugMode {
 		g.DebugMode = true
 	}
+	if *definedTypedefs {
+		g.DefinedTypedefs = true
+	}
+	g.SetType = *setType
+	if *keepUnknown {
+		g.KeepUnknownFields = true
+	}
 	err := g.Parse()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "parse:", err)
@@ -111,19 +111,21 @@ func (p *Thrift) parseConst(node *node32) *Constant {
 
 func (p *Thrift) parseFieldType(node *node32) *Type {
 	node = assertRule(node, ruleFieldType)
-	// BaseType / ContainerType / Identifier
+	// ( BaseType / ContainerType / Identifier ) Annotations?
+	var typ *Type
 	switch node.pegRule {
 	case ruleBaseType:
-		return &Type{Name: p.parsePegText(node), Category: TypeBasic}
+		typ = &Type{Name: p.parsePegText(node), Category: TypeBasic}
 	case ruleContainerType:
-		return p.parseContainerType(node)
+		typ = p.parseContainerType(node)
 	case ruleIdentifier:
-		typ := &Type{Name: p.parsePegText(node), Category: TypeIdentifier, D: p.D}
+		typ = &Type{Name: p.parsePegText(node), Category: TypeIdentifier, D: p.D}
 		p.D.IdentTypes[typ.Name] = typ
-		return typ
 	default:
 		panic("unknown field type rule: " + node.pegRule.String())
 	}
+	typ.Annotations = p.findAnnotations(node.next)
+	return typ
 }
 
 func (p *Thrift) parseContainerType(node *node32) *Type {
@@ -192,39 +194,47 @@ func (p *Thrift) parseConstValue(node *node32) interface{} {
 
 func (p *Thrift) parseTypedef(node *node32) *Typedef {
 	node = assertRule(node, ruleTypedef)
-	// TYPEDEF DefinitionType Identifier
+	// TYPEDEF DefinitionType Identifier Annotations? ListSeparator?
 	node = node.next // skip "typedef"
 	typ := p.parseDefinitionType(node)
 	node = node.next
 	alias := p.parsePegText(node)
-	return &Typedef{Type: typ, Alias: alias}
+	annotations := p.findAnnotations(node.next)
+	return &Typedef{Type: typ, Alias: alias, Annotations: annotations}
 }
 
 func (p *Thrift) parseDefinitionType(node *node32) *Type {
 	node = assertRule(node, ruleDefinitionType)
-	// BaseType / ContainerType / Identifier
+	// ( BaseType / ContainerType / Identifier ) Annotations?
+	var typ *Type
 	switch node.pegRule {
 	case ruleBaseType:
-		return &Type{Name: p.parsePegText(node), Category: TypeBasic}
+		typ = &Type{Name: p.parsePegText(node), Category: TypeBasic}
 	case ruleContainerType:
-		return p.parseContainerType(node)
+		typ = p.parseContainerType(node)
 	case ruleIdentifier:
-		typ := &Type{Name: p.parsePegText(node), Category: TypeIdentifier, D: p.D}
+		typ = &Type{Name: p.parsePegText(node), Category: TypeIdentifier, D: p.D}
 		p.D.IdentTypes[typ.Name] = typ
-		return typ
 	default:
 		panic("unknown definition type rule: " + node.pegRule.String())
 	}
+	typ.Annotations = p.findAnnotations(node.next)
+	return typ
 }
 
 func (p *Thrift) parseEnum(node *node32) *Enum {
 	node = assertRule(node, ruleEnum)
-	// ENUM Identifier LWING (Identifier (EQUAL IntConstant)? ListSeparator?)* RWING
+	// ENUM Identifier LWING (Identifier (EQUAL IntConstant)? Annotations? ListSeparator?)* RWING Annotations?
 	node = node.next // skip "enum"
 	name := p.parsePegText(node)
 	var values = make([]*EnumValue, 0)
+	var annotations []*Annotation
 	var preValue int
 	for n := node.next.next; n != nil; n = n.next {
+		if n.pegRule == ruleAnnotations {
+			// annotations of values are consumed below
+			annotations = p.parseAnnotations(n)
+		}
 		if n.pegRule == ruleIdentifier {
 			var v EnumValue
 			v.Name = p.parsePegText(n)
@@ -241,39 +251,46 @@ func (p *Thrift) parseEnum(node *node32) *Enum {
 					v.Value = preValue + 1
 				}
 			}
+			if n.next != nil && n.next.pegRule == ruleAnnotations {
+				n = n.next
+				v.Annotations = p.parseAnnotations(n)
+			}
 			preValue = v.Value
 			values = append(values, &v)
 		}
 	}
-	return &Enum{Name: name, Values: values}
+	return &Enum{Name: name, Values: values, Annotations: annotations}
 }
 
 func (p *Thrift) parseStruct(node *node32) *Struct {
 	node = assertRule(node, ruleStruct)
-	// STRUCT Identifier XSD_ALL? LWING Field* RWING
+	// STRUCT Identifier XSD_ALL? LWING Field* RWING Annotations?
 	node = node.next // skip "struct"
 	name := p.parsePegText(node)
 	fields := p.parseFields(node.next)
-	return &Struct{Name: name, Fields: fields}
+	annotations := p.findAnnotations(node.next)
+	return &Struct{Name: name, Fields: fields, Annotations: annotations}
 }
 
 func (p *Thrift) parseUnion(node *node32) *Union {
 	node = assertRule(node, ruleUnion)
-	// UNION Identifier XSD_ALL? LWING Field* RWING
+	// UNION Identifier XSD_ALL? LWING Field* RWING Annotations?
 	node = node.next // skip "union"
 	name := p.parsePegText(node)
 	fields := p.parseFields(node.next)
-	return &Union{Name: name, Fields: fields}
+	annotations := p.findAnnotations(node.next)
+	return &Union{Name: name, Fields: fields, Annotations: annotations}
 }
 
 // TODO
 func (p *Thrift) parseException(node *node32) Exception {
 	node = assertRule(node, ruleException)
-	// EXCEPTION Identifier LWING Field* RWING
+	// EXCEPTION Identifier LWING Field* RWING Annotations?
 	node = node.next // skip "exception"
 	name := p.parsePegText(node)
 	fields := p.parseFields(node.next)
-	return &Struct{Name: name, Fields: fields}
+	annotations := p.findAnnotations(node.next)
+	return &Struct{Name: name, Fields: fields, Annotations: annotations}
 }
 
 func (p *Thrift) parseFields(node *node32) []*Field {
@@ -296,7 +313,7 @@ func (p *Thrift) parseFields(node *node32) []*Field {
 
 func (p *Thrift) parseField(node *node32) *Field {
 	node = assertRule(node, ruleField)
-	// FieldID? FieldReq? FieldType Identifier (EQUAL ConstValue)? XsdFieldOptions ListSeparator?
+	// FieldID? FieldReq? FieldType Identifier (EQUAL ConstValue)? XsdFieldOptions Annotations? ListSeparator?
 	var f Field
 	f.ID = UNSETID
 	f.Requiredness = ReqDefault
@@ -313,6 +330,8 @@ func (p *Thrift) parseField(node *node32) *Field {
 			f.Name = p.parsePegText(n)
 		case ruleConstValue:
 			f.Default = p.parseConstValue(n)
+		case ruleAnnotations:
+			f.Annotations = p.parseAnnotations(n)
 		}
 	}
 	return &f
@@ -336,7 +355,7 @@ func (p *Thrift) parseFieldReqOptional(node *node32) (string, bool) {
 // TODO: extends
 func (p *Thrift) parseService(node *node32) *Service {
 	node = assertRule(node, ruleService)
-	// SERVICE Identifier ( EXTENDS Identifier )? LWING Function* RWING
+	// SERVICE Identifier ( EXTENDS Identifier )? LWING Function* RWING Annotations?
 	node = node.next // skip "service"
 	var s = Service{D: p.D}
 	s.Name = p.parsePegText(node)
@@ -350,12 +369,15 @@ func (p *Thrift) parseService(node *node32) *Service {
 			m := p.parseFunction(n.up)
 			s.Methods = append(s.Methods, m)
 		}
+		if n.pegRule == ruleAnnotations {
+			s.Annotations = p.parseAnnotations(n)
+		}
 	}
 	return &s
 }
 
 func (p *Thrift) parseFunction(node *node32) *Method {
-	// ONEWAY? FunctionType Identifier LPAR Field* RPAR Throws? ListSeparator?
+	// ONEWAY? FunctionType Identifier LPAR Field* RPAR Throws? Annotations? ListSeparator?
 	var f Method
 	for ; node != nil; node = node.next {
 		switch node.pegRule {
@@ -377,6 +399,8 @@ func (p *Thrift) parseFunction(node *node32) *Method {
 			f.Arguments = append(f.Arguments, field)
 		case ruleThrows:
 			f.Exceptions = p.parseThrows(node)
+		case ruleAnnotations:
+			f.Annotations = p.parseAnnotations(node)
 		}
 	}
 	return &f
@@ -402,6 +426,37 @@ func (p *Thrift) parseThrows(node *node32) []*Field {
 	return fields
 }
 
+// findAnnotations parses the first Annotations node from node and
+// its following siblings.
+func (p *Thrift) findAnnotations(node *node32) []*Annotation {
+	for n := node; n != nil; n = n.next {
+		if n.pegRule == ruleAnnotations {
+			return p.parseAnnotations(n)
+		}
+	}
+	return nil
+}
+
+func (p *Thrift) parseAnnotations(node *node32) []*Annotation {
+	node = assertRule(node, ruleAnnotations)
+	// LPAR Annotation* RPAR
+	var annotations []*Annotation
+	for n := node; n != nil; n = n.next {
+		if n.pegRule != ruleAnnotation {
+			continue
+		}
+		// Identifier ( EQUAL Literal )? ListSeparator?
+		a := n.up
+		// an annotation without value is treated as "1", like Apache Thrift
+		annotation := &Annotation{Name: p.parsePegText(a), Value: "1"}
+		if a.next != nil && a.next.pegRule == ruleEQUAL {
+			annotation.Value = p.parsePegText(a.next.next)
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations
+}
+
 func (d *Document) Parse() error {
 	d.RefName = strings.Split(filepath.Base(d.Filename), ".")[0]
 
@@ -468,7 +523,7 @@ func (d *Document) Parse() error {
 
 func (d *Document) ResolveIdentifierType(name string) interface{} {
 	for _, x := range d.Typedefs {
-		if x.Name == name {
+		if x.Alias == name {
 			return x.Type
 		}
 	}
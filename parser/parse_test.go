@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func parseString(t *testing.T, idl string) *Document {
+	dir, err := ioutil.TempDir("", "parser")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "test.thrift")
+	if err = ioutil.WriteFile(fn, []byte(idl), 0644); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := Parse(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+const annotationsIDL = `
+typedef i64 Timestamp (unit = "ms")
+
+enum Status {
+    OK = 0 (label = "ok"),
+    FAIL = 1,
+} (flags)
+
+struct Item {
+    1: required i64 id (go.tag = 'db:"id"', deprecated)
+    2: optional list<string> (len = "10") tags
+    3: Timestamp ts
+} (hash.key = "id", kind = "item")
+
+service ItemService {
+    Item GetItem(1: i64 id) (idempotent)
+    void Ping()
+} (version = "2")
+`
+
+func TestAnnotations(t *testing.T) {
+	is := is.New(t)
+	doc := parseString(t, annotationsIDL)
+
+	value, ok := GetAnnotation(doc.Typedefs[0].Annotations, "unit")
+	is.True(ok)
+	is.Equal(value, "ms")
+
+	enum := doc.Enums[0]
+	is.Equal(len(enum.Annotations), 1)
+	value, _ = GetAnnotation(enum.Annotations, "flags")
+	is.Equal(value, "1") // without value
+	value, _ = GetAnnotation(enum.Values[0].Annotations, "label")
+	is.Equal(value, "ok")
+	is.Equal(enum.Values[1].Value, 1)
+	is.Equal(len(enum.Values[1].Annotations), 0)
+
+	item := doc.Structs[0]
+	is.Equal(len(item.Annotations), 2)
+	value, _ = GetAnnotation(item.Annotations, "hash.key")
+	is.Equal(value, "id")
+	value, _ = GetAnnotation(item.Annotations, "kind")
+	is.Equal(value, "item")
+
+	id := item.Fields[0]
+	is.Equal(len(id.Annotations), 2)
+	value, _ = GetAnnotation(id.Annotations, "go.tag")
+	is.Equal(value, `db:"id"`)
+	_, ok = GetAnnotation(id.Annotations, "deprecated")
+	is.True(ok)
+	_, ok = GetAnnotation(id.Annotations, "missing")
+	is.True(!ok)
+
+	tags := item.Fields[1]
+	is.Equal(tags.Name, "tags")
+	is.Equal(len(tags.Annotations), 0)
+	value, _ = GetAnnotation(tags.Type.Annotations, "len")
+	is.Equal(value, "10")
+	is.Equal(len(item.Fields[2].Annotations), 0)
+
+	svc := doc.Services[0]
+	value, _ = GetAnnotation(svc.Annotations, "version")
+	is.Equal(value, "2")
+	_, ok = GetAnnotation(svc.Methods[0].Annotations, "idempotent")
+	is.True(ok)
+	is.Equal(len(svc.Methods[1].Annotations), 0)
+}
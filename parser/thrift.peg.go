@@ -47,6 +47,8 @@ const (
 	ruleSetType
 	ruleListType
 	ruleCppType
+	ruleAnnotations
+	ruleAnnotation
 	ruleConstValue
 	ruleDoubleConstant
 	ruleExponent
@@ -151,6 +153,8 @@ var rul3s = [...]string{
 	"SetType",
 	"ListType",
 	"CppType",
+	"Annotations",
+	"Annotation",
 	"ConstValue",
 	"DoubleConstant",
 	"Exponent",
@@ -336,7 +340,7 @@ type Thrift struct {
 
 	Buffer string
 	buffer []rune
-	rules  [101]func() bool
+	rules  [103]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -784,7 +788,7 @@ func (p *Thrift) Init() {
 			position, tokenIndex = position37, tokenIndex37
 			return false
 		},
-		/* 8 Typedef <- <(TYPEDEF DefinitionType Identifier)> */
+		/* 8 Typedef <- <(TYPEDEF DefinitionType Identifier Annotations? ListSeparator?)> */
 		func() bool {
 			position41, tokenIndex41 := position, tokenIndex
 			{
@@ -798,6 +802,26 @@ func (p *Thrift) Init() {
 				if !_rules[ruleIdentifier]() {
 					goto l41
 				}
+				{
+					position43, tokenIndex43 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l43
+					}
+					goto l44
+				l43:
+					position, tokenIndex = position43, tokenIndex43
+				}
+			l44:
+				{
+					position45, tokenIndex45 := position, tokenIndex
+					if !_rules[ruleListSeparator]() {
+						goto l45
+					}
+					goto l46
+				l45:
+					position, tokenIndex = position45, tokenIndex45
+				}
+			l46:
 				add(ruleTypedef, position42)
 			}
 			return true
@@ -805,4188 +829,4354 @@ func (p *Thrift) Init() {
 			position, tokenIndex = position41, tokenIndex41
 			return false
 		},
-		/* 9 Enum <- <(ENUM Identifier LWING (Identifier (EQUAL IntConstant)? ListSeparator?)* RWING)> */
+		/* 9 Enum <- <(ENUM Identifier LWING (Identifier (EQUAL IntConstant)? Annotations? ListSeparator?)* RWING Annotations?)> */
 		func() bool {
-			position43, tokenIndex43 := position, tokenIndex
+			position47, tokenIndex47 := position, tokenIndex
 			{
-				position44 := position
+				position48 := position
 				if !_rules[ruleENUM]() {
-					goto l43
+					goto l47
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l43
+					goto l47
 				}
 				if !_rules[ruleLWING]() {
-					goto l43
+					goto l47
 				}
-			l45:
+			l49:
 				{
-					position46, tokenIndex46 := position, tokenIndex
+					position50, tokenIndex50 := position, tokenIndex
 					if !_rules[ruleIdentifier]() {
-						goto l46
+						goto l50
 					}
 					{
-						position47, tokenIndex47 := position, tokenIndex
+						position51, tokenIndex51 := position, tokenIndex
 						if !_rules[ruleEQUAL]() {
-							goto l47
+							goto l51
 						}
 						if !_rules[ruleIntConstant]() {
-							goto l47
+							goto l51
+						}
+						goto l52
+					l51:
+						position, tokenIndex = position51, tokenIndex51
+					}
+				l52:
+					{
+						position53, tokenIndex53 := position, tokenIndex
+						if !_rules[ruleAnnotations]() {
+							goto l53
 						}
-						goto l48
-					l47:
-						position, tokenIndex = position47, tokenIndex47
+						goto l54
+					l53:
+						position, tokenIndex = position53, tokenIndex53
 					}
-				l48:
+				l54:
 					{
-						position49, tokenIndex49 := position, tokenIndex
+						position55, tokenIndex55 := position, tokenIndex
 						if !_rules[ruleListSeparator]() {
-							goto l49
+							goto l55
 						}
-						goto l50
-					l49:
-						position, tokenIndex = position49, tokenIndex49
+						goto l56
+					l55:
+						position, tokenIndex = position55, tokenIndex55
 					}
+				l56:
+					goto l49
 				l50:
-					goto l45
-				l46:
-					position, tokenIndex = position46, tokenIndex46
+					position, tokenIndex = position50, tokenIndex50
 				}
 				if !_rules[ruleRWING]() {
-					goto l43
+					goto l47
+				}
+				{
+					position57, tokenIndex57 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l57
+					}
+					goto l58
+				l57:
+					position, tokenIndex = position57, tokenIndex57
 				}
-				add(ruleEnum, position44)
+			l58:
+				add(ruleEnum, position48)
 			}
 			return true
-		l43:
-			position, tokenIndex = position43, tokenIndex43
+		l47:
+			position, tokenIndex = position47, tokenIndex47
 			return false
 		},
 		/* 10 Senum <- <(SENUM Identifier LWING (Literal ListSeparator?)* RWING)> */
 		func() bool {
-			position51, tokenIndex51 := position, tokenIndex
+			position59, tokenIndex59 := position, tokenIndex
 			{
-				position52 := position
+				position60 := position
 				if !_rules[ruleSENUM]() {
-					goto l51
+					goto l59
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l51
+					goto l59
 				}
 				if !_rules[ruleLWING]() {
-					goto l51
+					goto l59
 				}
-			l53:
+			l61:
 				{
-					position54, tokenIndex54 := position, tokenIndex
+					position62, tokenIndex62 := position, tokenIndex
 					if !_rules[ruleLiteral]() {
-						goto l54
+						goto l62
 					}
 					{
-						position55, tokenIndex55 := position, tokenIndex
+						position63, tokenIndex63 := position, tokenIndex
 						if !_rules[ruleListSeparator]() {
-							goto l55
+							goto l63
 						}
-						goto l56
-					l55:
-						position, tokenIndex = position55, tokenIndex55
+						goto l64
+					l63:
+						position, tokenIndex = position63, tokenIndex63
 					}
-				l56:
-					goto l53
-				l54:
-					position, tokenIndex = position54, tokenIndex54
+				l64:
+					goto l61
+				l62:
+					position, tokenIndex = position62, tokenIndex62
 				}
 				if !_rules[ruleRWING]() {
-					goto l51
+					goto l59
 				}
-				add(ruleSenum, position52)
+				add(ruleSenum, position60)
 			}
 			return true
-		l51:
-			position, tokenIndex = position51, tokenIndex51
+		l59:
+			position, tokenIndex = position59, tokenIndex59
 			return false
 		},
-		/* 11 Struct <- <(STRUCT Identifier XSD_ALL? LWING Field* RWING)> */
+		/* 11 Struct <- <(STRUCT Identifier XSD_ALL? LWING Field* RWING Annotations?)> */
 		func() bool {
-			position57, tokenIndex57 := position, tokenIndex
+			position65, tokenIndex65 := position, tokenIndex
 			{
-				position58 := position
+				position66 := position
 				if !_rules[ruleSTRUCT]() {
-					goto l57
+					goto l65
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l57
+					goto l65
 				}
 				{
-					position59, tokenIndex59 := position, tokenIndex
+					position67, tokenIndex67 := position, tokenIndex
 					if !_rules[ruleXSD_ALL]() {
-						goto l59
+						goto l67
 					}
-					goto l60
-				l59:
-					position, tokenIndex = position59, tokenIndex59
+					goto l68
+				l67:
+					position, tokenIndex = position67, tokenIndex67
 				}
-			l60:
+			l68:
 				if !_rules[ruleLWING]() {
-					goto l57
+					goto l65
 				}
-			l61:
+			l69:
 				{
-					position62, tokenIndex62 := position, tokenIndex
+					position70, tokenIndex70 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l62
+						goto l70
 					}
-					goto l61
-				l62:
-					position, tokenIndex = position62, tokenIndex62
+					goto l69
+				l70:
+					position, tokenIndex = position70, tokenIndex70
 				}
 				if !_rules[ruleRWING]() {
-					goto l57
+					goto l65
+				}
+				{
+					position71, tokenIndex71 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l71
+					}
+					goto l72
+				l71:
+					position, tokenIndex = position71, tokenIndex71
 				}
-				add(ruleStruct, position58)
+			l72:
+				add(ruleStruct, position66)
 			}
 			return true
-		l57:
-			position, tokenIndex = position57, tokenIndex57
+		l65:
+			position, tokenIndex = position65, tokenIndex65
 			return false
 		},
-		/* 12 Union <- <(UNION Identifier XSD_ALL? LWING Field* RWING)> */
+		/* 12 Union <- <(UNION Identifier XSD_ALL? LWING Field* RWING Annotations?)> */
 		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
+			position73, tokenIndex73 := position, tokenIndex
 			{
-				position64 := position
+				position74 := position
 				if !_rules[ruleUNION]() {
-					goto l63
+					goto l73
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l63
+					goto l73
 				}
 				{
-					position65, tokenIndex65 := position, tokenIndex
+					position75, tokenIndex75 := position, tokenIndex
 					if !_rules[ruleXSD_ALL]() {
-						goto l65
+						goto l75
 					}
-					goto l66
-				l65:
-					position, tokenIndex = position65, tokenIndex65
+					goto l76
+				l75:
+					position, tokenIndex = position75, tokenIndex75
 				}
-			l66:
+			l76:
 				if !_rules[ruleLWING]() {
-					goto l63
+					goto l73
 				}
-			l67:
+			l77:
 				{
-					position68, tokenIndex68 := position, tokenIndex
+					position78, tokenIndex78 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l68
+						goto l78
 					}
-					goto l67
-				l68:
-					position, tokenIndex = position68, tokenIndex68
+					goto l77
+				l78:
+					position, tokenIndex = position78, tokenIndex78
 				}
 				if !_rules[ruleRWING]() {
-					goto l63
+					goto l73
+				}
+				{
+					position79, tokenIndex79 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l79
+					}
+					goto l80
+				l79:
+					position, tokenIndex = position79, tokenIndex79
 				}
-				add(ruleUnion, position64)
+			l80:
+				add(ruleUnion, position74)
 			}
 			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
+		l73:
+			position, tokenIndex = position73, tokenIndex73
 			return false
 		},
-		/* 13 Exception <- <(EXCEPTION Identifier LWING Field* RWING)> */
+		/* 13 Exception <- <(EXCEPTION Identifier LWING Field* RWING Annotations?)> */
 		func() bool {
-			position69, tokenIndex69 := position, tokenIndex
+			position81, tokenIndex81 := position, tokenIndex
 			{
-				position70 := position
+				position82 := position
 				if !_rules[ruleEXCEPTION]() {
-					goto l69
+					goto l81
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l69
+					goto l81
 				}
 				if !_rules[ruleLWING]() {
-					goto l69
+					goto l81
 				}
-			l71:
+			l83:
 				{
-					position72, tokenIndex72 := position, tokenIndex
+					position84, tokenIndex84 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l72
+						goto l84
 					}
-					goto l71
-				l72:
-					position, tokenIndex = position72, tokenIndex72
+					goto l83
+				l84:
+					position, tokenIndex = position84, tokenIndex84
 				}
 				if !_rules[ruleRWING]() {
-					goto l69
+					goto l81
+				}
+				{
+					position85, tokenIndex85 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l85
+					}
+					goto l86
+				l85:
+					position, tokenIndex = position85, tokenIndex85
 				}
-				add(ruleException, position70)
+			l86:
+				add(ruleException, position82)
 			}
 			return true
-		l69:
-			position, tokenIndex = position69, tokenIndex69
+		l81:
+			position, tokenIndex = position81, tokenIndex81
 			return false
 		},
-		/* 14 Service <- <(SERVICE Identifier (EXTENDS Identifier)? LWING Function* RWING)> */
+		/* 14 Service <- <(SERVICE Identifier (EXTENDS Identifier)? LWING Function* RWING Annotations?)> */
 		func() bool {
-			position73, tokenIndex73 := position, tokenIndex
+			position87, tokenIndex87 := position, tokenIndex
 			{
-				position74 := position
+				position88 := position
 				if !_rules[ruleSERVICE]() {
-					goto l73
+					goto l87
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l73
+					goto l87
 				}
 				{
-					position75, tokenIndex75 := position, tokenIndex
+					position89, tokenIndex89 := position, tokenIndex
 					if !_rules[ruleEXTENDS]() {
-						goto l75
+						goto l89
 					}
 					if !_rules[ruleIdentifier]() {
-						goto l75
+						goto l89
 					}
-					goto l76
-				l75:
-					position, tokenIndex = position75, tokenIndex75
+					goto l90
+				l89:
+					position, tokenIndex = position89, tokenIndex89
 				}
-			l76:
+			l90:
 				if !_rules[ruleLWING]() {
-					goto l73
+					goto l87
 				}
-			l77:
+			l91:
 				{
-					position78, tokenIndex78 := position, tokenIndex
+					position92, tokenIndex92 := position, tokenIndex
 					if !_rules[ruleFunction]() {
-						goto l78
+						goto l92
 					}
-					goto l77
-				l78:
-					position, tokenIndex = position78, tokenIndex78
+					goto l91
+				l92:
+					position, tokenIndex = position92, tokenIndex92
 				}
 				if !_rules[ruleRWING]() {
-					goto l73
+					goto l87
+				}
+				{
+					position93, tokenIndex93 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l93
+					}
+					goto l94
+				l93:
+					position, tokenIndex = position93, tokenIndex93
 				}
-				add(ruleService, position74)
+			l94:
+				add(ruleService, position88)
 			}
 			return true
-		l73:
-			position, tokenIndex = position73, tokenIndex73
+		l87:
+			position, tokenIndex = position87, tokenIndex87
 			return false
 		},
-		/* 15 Field <- <(FieldID? FieldReq? FieldType Identifier (EQUAL ConstValue)? XsdFieldOptions ListSeparator?)> */
+		/* 15 Field <- <(FieldID? FieldReq? FieldType Identifier (EQUAL ConstValue)? XsdFieldOptions Annotations? ListSeparator?)> */
 		func() bool {
-			position79, tokenIndex79 := position, tokenIndex
+			position95, tokenIndex95 := position, tokenIndex
 			{
-				position80 := position
+				position96 := position
 				{
-					position81, tokenIndex81 := position, tokenIndex
+					position97, tokenIndex97 := position, tokenIndex
 					if !_rules[ruleFieldID]() {
-						goto l81
+						goto l97
 					}
-					goto l82
-				l81:
-					position, tokenIndex = position81, tokenIndex81
+					goto l98
+				l97:
+					position, tokenIndex = position97, tokenIndex97
 				}
-			l82:
+			l98:
 				{
-					position83, tokenIndex83 := position, tokenIndex
+					position99, tokenIndex99 := position, tokenIndex
 					if !_rules[ruleFieldReq]() {
-						goto l83
+						goto l99
 					}
-					goto l84
-				l83:
-					position, tokenIndex = position83, tokenIndex83
+					goto l100
+				l99:
+					position, tokenIndex = position99, tokenIndex99
 				}
-			l84:
+			l100:
 				if !_rules[ruleFieldType]() {
-					goto l79
+					goto l95
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l79
+					goto l95
 				}
 				{
-					position85, tokenIndex85 := position, tokenIndex
+					position101, tokenIndex101 := position, tokenIndex
 					if !_rules[ruleEQUAL]() {
-						goto l85
+						goto l101
 					}
 					if !_rules[ruleConstValue]() {
-						goto l85
+						goto l101
 					}
-					goto l86
-				l85:
-					position, tokenIndex = position85, tokenIndex85
+					goto l102
+				l101:
+					position, tokenIndex = position101, tokenIndex101
 				}
-			l86:
+			l102:
 				if !_rules[ruleXsdFieldOptions]() {
-					goto l79
+					goto l95
+				}
+				{
+					position103, tokenIndex103 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l103
+					}
+					goto l104
+				l103:
+					position, tokenIndex = position103, tokenIndex103
 				}
+			l104:
 				{
-					position87, tokenIndex87 := position, tokenIndex
+					position105, tokenIndex105 := position, tokenIndex
 					if !_rules[ruleListSeparator]() {
-						goto l87
+						goto l105
 					}
-					goto l88
-				l87:
-					position, tokenIndex = position87, tokenIndex87
+					goto l106
+				l105:
+					position, tokenIndex = position105, tokenIndex105
 				}
-			l88:
-				add(ruleField, position80)
+			l106:
+				add(ruleField, position96)
 			}
 			return true
-		l79:
-			position, tokenIndex = position79, tokenIndex79
+		l95:
+			position, tokenIndex = position95, tokenIndex95
 			return false
 		},
 		/* 16 FieldID <- <(IntConstant COLON)> */
 		func() bool {
-			position89, tokenIndex89 := position, tokenIndex
+			position107, tokenIndex107 := position, tokenIndex
 			{
-				position90 := position
+				position108 := position
 				if !_rules[ruleIntConstant]() {
-					goto l89
+					goto l107
 				}
 				if !_rules[ruleCOLON]() {
-					goto l89
+					goto l107
 				}
-				add(ruleFieldID, position90)
+				add(ruleFieldID, position108)
 			}
 			return true
-		l89:
-			position, tokenIndex = position89, tokenIndex89
+		l107:
+			position, tokenIndex = position107, tokenIndex107
 			return false
 		},
 		/* 17 FieldReq <- <(<(('r' 'e' 'q' 'u' 'i' 'r' 'e' 'd') / ('o' 'p' 't' 'i' 'o' 'n' 'a' 'l'))> Spacing)> */
 		func() bool {
-			position91, tokenIndex91 := position, tokenIndex
+			position109, tokenIndex109 := position, tokenIndex
 			{
-				position92 := position
+				position110 := position
 				{
-					position93 := position
+					position111 := position
 					{
-						position94, tokenIndex94 := position, tokenIndex
+						position112, tokenIndex112 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('q') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l95
+							goto l113
 						}
 						position++
 						if buffer[position] != rune('d') {
-							goto l95
+							goto l113
 						}
 						position++
-						goto l94
-					l95:
-						position, tokenIndex = position94, tokenIndex94
+						goto l112
+					l113:
+						position, tokenIndex = position112, tokenIndex112
 						if buffer[position] != rune('o') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('a') {
-							goto l91
+							goto l109
 						}
 						position++
 						if buffer[position] != rune('l') {
-							goto l91
+							goto l109
 						}
 						position++
 					}
-				l94:
-					add(rulePegText, position93)
+				l112:
+					add(rulePegText, position111)
 				}
 				if !_rules[ruleSpacing]() {
-					goto l91
+					goto l109
 				}
-				add(ruleFieldReq, position92)
+				add(ruleFieldReq, position110)
 			}
 			return true
-		l91:
-			position, tokenIndex = position91, tokenIndex91
+		l109:
+			position, tokenIndex = position109, tokenIndex109
 			return false
 		},
 		/* 18 XsdFieldOptions <- <(XSD_OPTIONAL? / XSD_NILLABLE? / XsdAttrs?)> */
 		func() bool {
 			{
-				position97 := position
+				position115 := position
 				{
-					position98, tokenIndex98 := position, tokenIndex
+					position116, tokenIndex116 := position, tokenIndex
 					{
-						position100, tokenIndex100 := position, tokenIndex
+						position118, tokenIndex118 := position, tokenIndex
 						if !_rules[ruleXSD_OPTIONAL]() {
-							goto l100
+							goto l118
 						}
-						goto l101
-					l100:
-						position, tokenIndex = position100, tokenIndex100
+						goto l119
+					l118:
+						position, tokenIndex = position118, tokenIndex118
 					}
-				l101:
-					goto l98
+				l119:
+					goto l116
 
-					position, tokenIndex = position98, tokenIndex98
+					position, tokenIndex = position116, tokenIndex116
 					{
-						position103, tokenIndex103 := position, tokenIndex
+						position121, tokenIndex121 := position, tokenIndex
 						if !_rules[ruleXSD_NILLABLE]() {
-							goto l103
+							goto l121
 						}
-						goto l104
-					l103:
-						position, tokenIndex = position103, tokenIndex103
+						goto l122
+					l121:
+						position, tokenIndex = position121, tokenIndex121
 					}
-				l104:
-					goto l98
+				l122:
+					goto l116
 
-					position, tokenIndex = position98, tokenIndex98
+					position, tokenIndex = position116, tokenIndex116
 					{
-						position105, tokenIndex105 := position, tokenIndex
+						position123, tokenIndex123 := position, tokenIndex
 						if !_rules[ruleXsdAttrs]() {
-							goto l105
+							goto l123
 						}
-						goto l106
-					l105:
-						position, tokenIndex = position105, tokenIndex105
+						goto l124
+					l123:
+						position, tokenIndex = position123, tokenIndex123
 					}
-				l106:
+				l124:
 				}
-			l98:
-				add(ruleXsdFieldOptions, position97)
+			l116:
+				add(ruleXsdFieldOptions, position115)
 			}
 			return true
 		},
 		/* 19 XsdAttrs <- <(XSD_ATTRS LWING Field* RWING)> */
 		func() bool {
-			position107, tokenIndex107 := position, tokenIndex
+			position125, tokenIndex125 := position, tokenIndex
 			{
-				position108 := position
+				position126 := position
 				if !_rules[ruleXSD_ATTRS]() {
-					goto l107
+					goto l125
 				}
 				if !_rules[ruleLWING]() {
-					goto l107
+					goto l125
 				}
-			l109:
+			l127:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position128, tokenIndex128 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l110
+						goto l128
 					}
-					goto l109
-				l110:
-					position, tokenIndex = position110, tokenIndex110
+					goto l127
+				l128:
+					position, tokenIndex = position128, tokenIndex128
 				}
 				if !_rules[ruleRWING]() {
-					goto l107
+					goto l125
 				}
-				add(ruleXsdAttrs, position108)
+				add(ruleXsdAttrs, position126)
 			}
 			return true
-		l107:
-			position, tokenIndex = position107, tokenIndex107
+		l125:
+			position, tokenIndex = position125, tokenIndex125
 			return false
 		},
-		/* 20 Function <- <(ONEWAY? FunctionType Identifier LPAR Field* RPAR Throws? ListSeparator?)> */
+		/* 20 Function <- <(ONEWAY? FunctionType Identifier LPAR Field* RPAR Throws? Annotations? ListSeparator?)> */
 		func() bool {
-			position111, tokenIndex111 := position, tokenIndex
+			position129, tokenIndex129 := position, tokenIndex
 			{
-				position112 := position
+				position130 := position
 				{
-					position113, tokenIndex113 := position, tokenIndex
+					position131, tokenIndex131 := position, tokenIndex
 					if !_rules[ruleONEWAY]() {
-						goto l113
+						goto l131
 					}
-					goto l114
-				l113:
-					position, tokenIndex = position113, tokenIndex113
+					goto l132
+				l131:
+					position, tokenIndex = position131, tokenIndex131
 				}
-			l114:
+			l132:
 				if !_rules[ruleFunctionType]() {
-					goto l111
+					goto l129
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l111
+					goto l129
 				}
 				if !_rules[ruleLPAR]() {
-					goto l111
+					goto l129
 				}
-			l115:
+			l133:
 				{
-					position116, tokenIndex116 := position, tokenIndex
+					position134, tokenIndex134 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l116
+						goto l134
 					}
-					goto l115
-				l116:
-					position, tokenIndex = position116, tokenIndex116
+					goto l133
+				l134:
+					position, tokenIndex = position134, tokenIndex134
 				}
 				if !_rules[ruleRPAR]() {
-					goto l111
+					goto l129
 				}
 				{
-					position117, tokenIndex117 := position, tokenIndex
+					position135, tokenIndex135 := position, tokenIndex
 					if !_rules[ruleThrows]() {
-						goto l117
+						goto l135
+					}
+					goto l136
+				l135:
+					position, tokenIndex = position135, tokenIndex135
+				}
+			l136:
+				{
+					position137, tokenIndex137 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l137
 					}
-					goto l118
-				l117:
-					position, tokenIndex = position117, tokenIndex117
+					goto l138
+				l137:
+					position, tokenIndex = position137, tokenIndex137
 				}
-			l118:
+			l138:
 				{
-					position119, tokenIndex119 := position, tokenIndex
+					position139, tokenIndex139 := position, tokenIndex
 					if !_rules[ruleListSeparator]() {
-						goto l119
+						goto l139
 					}
-					goto l120
-				l119:
-					position, tokenIndex = position119, tokenIndex119
+					goto l140
+				l139:
+					position, tokenIndex = position139, tokenIndex139
 				}
-			l120:
-				add(ruleFunction, position112)
+			l140:
+				add(ruleFunction, position130)
 			}
 			return true
-		l111:
-			position, tokenIndex = position111, tokenIndex111
+		l129:
+			position, tokenIndex = position129, tokenIndex129
 			return false
 		},
 		/* 21 FunctionType <- <(VOID / FieldType)> */
 		func() bool {
-			position121, tokenIndex121 := position, tokenIndex
+			position141, tokenIndex141 := position, tokenIndex
 			{
-				position122 := position
+				position142 := position
 				{
-					position123, tokenIndex123 := position, tokenIndex
+					position143, tokenIndex143 := position, tokenIndex
 					if !_rules[ruleVOID]() {
-						goto l124
+						goto l144
 					}
-					goto l123
-				l124:
-					position, tokenIndex = position123, tokenIndex123
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
 					if !_rules[ruleFieldType]() {
-						goto l121
+						goto l141
 					}
 				}
-			l123:
-				add(ruleFunctionType, position122)
+			l143:
+				add(ruleFunctionType, position142)
 			}
 			return true
-		l121:
-			position, tokenIndex = position121, tokenIndex121
+		l141:
+			position, tokenIndex = position141, tokenIndex141
 			return false
 		},
 		/* 22 Throws <- <(THROWS LPAR Field* RPAR)> */
 		func() bool {
-			position125, tokenIndex125 := position, tokenIndex
+			position145, tokenIndex145 := position, tokenIndex
 			{
-				position126 := position
+				position146 := position
 				if !_rules[ruleTHROWS]() {
-					goto l125
+					goto l145
 				}
 				if !_rules[ruleLPAR]() {
-					goto l125
+					goto l145
 				}
-			l127:
+			l147:
 				{
-					position128, tokenIndex128 := position, tokenIndex
+					position148, tokenIndex148 := position, tokenIndex
 					if !_rules[ruleField]() {
-						goto l128
+						goto l148
 					}
-					goto l127
-				l128:
-					position, tokenIndex = position128, tokenIndex128
+					goto l147
+				l148:
+					position, tokenIndex = position148, tokenIndex148
 				}
 				if !_rules[ruleRPAR]() {
-					goto l125
+					goto l145
 				}
-				add(ruleThrows, position126)
+				add(ruleThrows, position146)
 			}
 			return true
-		l125:
-			position, tokenIndex = position125, tokenIndex125
+		l145:
+			position, tokenIndex = position145, tokenIndex145
 			return false
 		},
-		/* 23 FieldType <- <(BaseType / ContainerType / Identifier)> */
+		/* 23 FieldType <- <((BaseType / ContainerType / Identifier) Annotations?)> */
 		func() bool {
-			position129, tokenIndex129 := position, tokenIndex
+			position149, tokenIndex149 := position, tokenIndex
 			{
-				position130 := position
+				position150 := position
 				{
-					position131, tokenIndex131 := position, tokenIndex
+					position151, tokenIndex151 := position, tokenIndex
 					if !_rules[ruleBaseType]() {
-						goto l132
+						goto l152
 					}
-					goto l131
-				l132:
-					position, tokenIndex = position131, tokenIndex131
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
 					if !_rules[ruleContainerType]() {
-						goto l133
+						goto l153
 					}
-					goto l131
-				l133:
-					position, tokenIndex = position131, tokenIndex131
+					goto l151
+				l153:
+					position, tokenIndex = position151, tokenIndex151
 					if !_rules[ruleIdentifier]() {
-						goto l129
+						goto l149
 					}
 				}
-			l131:
-				add(ruleFieldType, position130)
+			l151:
+				{
+					position154, tokenIndex154 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l154
+					}
+					goto l155
+				l154:
+					position, tokenIndex = position154, tokenIndex154
+				}
+			l155:
+				add(ruleFieldType, position150)
 			}
 			return true
-		l129:
-			position, tokenIndex = position129, tokenIndex129
+		l149:
+			position, tokenIndex = position149, tokenIndex149
 			return false
 		},
-		/* 24 DefinitionType <- <(BaseType / ContainerType / Identifier)> */
+		/* 24 DefinitionType <- <((BaseType / ContainerType / Identifier) Annotations?)> */
 		func() bool {
-			position134, tokenIndex134 := position, tokenIndex
+			position156, tokenIndex156 := position, tokenIndex
 			{
-				position135 := position
+				position157 := position
 				{
-					position136, tokenIndex136 := position, tokenIndex
+					position158, tokenIndex158 := position, tokenIndex
 					if !_rules[ruleBaseType]() {
-						goto l137
+						goto l159
 					}
-					goto l136
-				l137:
-					position, tokenIndex = position136, tokenIndex136
+					goto l158
+				l159:
+					position, tokenIndex = position158, tokenIndex158
 					if !_rules[ruleContainerType]() {
-						goto l138
+						goto l160
 					}
-					goto l136
-				l138:
-					position, tokenIndex = position136, tokenIndex136
+					goto l158
+				l160:
+					position, tokenIndex = position158, tokenIndex158
 					if !_rules[ruleIdentifier]() {
-						goto l134
+						goto l156
 					}
 				}
-			l136:
-				add(ruleDefinitionType, position135)
+			l158:
+				{
+					position161, tokenIndex161 := position, tokenIndex
+					if !_rules[ruleAnnotations]() {
+						goto l161
+					}
+					goto l162
+				l161:
+					position, tokenIndex = position161, tokenIndex161
+				}
+			l162:
+				add(ruleDefinitionType, position157)
 			}
 			return true
-		l134:
-			position, tokenIndex = position134, tokenIndex134
+		l156:
+			position, tokenIndex = position156, tokenIndex156
 			return false
 		},
 		/* 25 BaseType <- <(BOOL / BYTE / I8 / I16 / I32 / I64 / DOUBLE / STRING / BINARY / SLIST / FLOAT)> */
 		func() bool {
-			position139, tokenIndex139 := position, tokenIndex
+			position163, tokenIndex163 := position, tokenIndex
 			{
-				position140 := position
+				position164 := position
 				{
-					position141, tokenIndex141 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
 					if !_rules[ruleBOOL]() {
-						goto l142
+						goto l166
 					}
-					goto l141
-				l142:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleBYTE]() {
-						goto l143
+						goto l167
 					}
-					goto l141
-				l143:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l167:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleI8]() {
-						goto l144
+						goto l168
 					}
-					goto l141
-				l144:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l168:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleI16]() {
-						goto l145
+						goto l169
 					}
-					goto l141
-				l145:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l169:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleI32]() {
-						goto l146
+						goto l170
 					}
-					goto l141
-				l146:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l170:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleI64]() {
-						goto l147
+						goto l171
 					}
-					goto l141
-				l147:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l171:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleDOUBLE]() {
-						goto l148
+						goto l172
 					}
-					goto l141
-				l148:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l172:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleSTRING]() {
-						goto l149
+						goto l173
 					}
-					goto l141
-				l149:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l173:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleBINARY]() {
-						goto l150
+						goto l174
 					}
-					goto l141
-				l150:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l174:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleSLIST]() {
-						goto l151
+						goto l175
 					}
-					goto l141
-				l151:
-					position, tokenIndex = position141, tokenIndex141
+					goto l165
+				l175:
+					position, tokenIndex = position165, tokenIndex165
 					if !_rules[ruleFLOAT]() {
-						goto l139
+						goto l163
 					}
 				}
-			l141:
-				add(ruleBaseType, position140)
+			l165:
+				add(ruleBaseType, position164)
 			}
 			return true
-		l139:
-			position, tokenIndex = position139, tokenIndex139
+		l163:
+			position, tokenIndex = position163, tokenIndex163
 			return false
 		},
 		/* 26 ContainerType <- <(MapType / SetType / ListType)> */
 		func() bool {
-			position152, tokenIndex152 := position, tokenIndex
+			position176, tokenIndex176 := position, tokenIndex
 			{
-				position153 := position
+				position177 := position
 				{
-					position154, tokenIndex154 := position, tokenIndex
+					position178, tokenIndex178 := position, tokenIndex
 					if !_rules[ruleMapType]() {
-						goto l155
+						goto l179
 					}
-					goto l154
-				l155:
-					position, tokenIndex = position154, tokenIndex154
+					goto l178
+				l179:
+					position, tokenIndex = position178, tokenIndex178
 					if !_rules[ruleSetType]() {
-						goto l156
+						goto l180
 					}
-					goto l154
-				l156:
-					position, tokenIndex = position154, tokenIndex154
+					goto l178
+				l180:
+					position, tokenIndex = position178, tokenIndex178
 					if !_rules[ruleListType]() {
-						goto l152
+						goto l176
 					}
 				}
-			l154:
-				add(ruleContainerType, position153)
+			l178:
+				add(ruleContainerType, position177)
 			}
 			return true
-		l152:
-			position, tokenIndex = position152, tokenIndex152
+		l176:
+			position, tokenIndex = position176, tokenIndex176
 			return false
 		},
 		/* 27 MapType <- <(MAP CppType? LPOINT FieldType COMMA FieldType RPOINT)> */
 		func() bool {
-			position157, tokenIndex157 := position, tokenIndex
+			position181, tokenIndex181 := position, tokenIndex
 			{
-				position158 := position
+				position182 := position
 				if !_rules[ruleMAP]() {
-					goto l157
+					goto l181
 				}
 				{
-					position159, tokenIndex159 := position, tokenIndex
+					position183, tokenIndex183 := position, tokenIndex
 					if !_rules[ruleCppType]() {
-						goto l159
+						goto l183
 					}
-					goto l160
-				l159:
-					position, tokenIndex = position159, tokenIndex159
+					goto l184
+				l183:
+					position, tokenIndex = position183, tokenIndex183
 				}
-			l160:
+			l184:
 				if !_rules[ruleLPOINT]() {
-					goto l157
+					goto l181
 				}
 				if !_rules[ruleFieldType]() {
-					goto l157
+					goto l181
 				}
 				if !_rules[ruleCOMMA]() {
-					goto l157
+					goto l181
 				}
 				if !_rules[ruleFieldType]() {
-					goto l157
+					goto l181
 				}
 				if !_rules[ruleRPOINT]() {
-					goto l157
+					goto l181
 				}
-				add(ruleMapType, position158)
+				add(ruleMapType, position182)
 			}
 			return true
-		l157:
-			position, tokenIndex = position157, tokenIndex157
+		l181:
+			position, tokenIndex = position181, tokenIndex181
 			return false
 		},
 		/* 28 SetType <- <(SET CppType? LPOINT FieldType RPOINT)> */
 		func() bool {
-			position161, tokenIndex161 := position, tokenIndex
+			position185, tokenIndex185 := position, tokenIndex
 			{
-				position162 := position
+				position186 := position
 				if !_rules[ruleSET]() {
-					goto l161
+					goto l185
 				}
 				{
-					position163, tokenIndex163 := position, tokenIndex
+					position187, tokenIndex187 := position, tokenIndex
 					if !_rules[ruleCppType]() {
-						goto l163
+						goto l187
 					}
-					goto l164
-				l163:
-					position, tokenIndex = position163, tokenIndex163
+					goto l188
+				l187:
+					position, tokenIndex = position187, tokenIndex187
 				}
-			l164:
+			l188:
 				if !_rules[ruleLPOINT]() {
-					goto l161
+					goto l185
 				}
 				if !_rules[ruleFieldType]() {
-					goto l161
+					goto l185
 				}
 				if !_rules[ruleRPOINT]() {
-					goto l161
+					goto l185
 				}
-				add(ruleSetType, position162)
+				add(ruleSetType, position186)
 			}
 			return true
-		l161:
-			position, tokenIndex = position161, tokenIndex161
+		l185:
+			position, tokenIndex = position185, tokenIndex185
 			return false
 		},
 		/* 29 ListType <- <(LIST LPOINT FieldType RPOINT CppType?)> */
 		func() bool {
-			position165, tokenIndex165 := position, tokenIndex
+			position189, tokenIndex189 := position, tokenIndex
 			{
-				position166 := position
+				position190 := position
 				if !_rules[ruleLIST]() {
-					goto l165
+					goto l189
 				}
 				if !_rules[ruleLPOINT]() {
-					goto l165
+					goto l189
 				}
 				if !_rules[ruleFieldType]() {
-					goto l165
+					goto l189
 				}
 				if !_rules[ruleRPOINT]() {
-					goto l165
+					goto l189
 				}
 				{
-					position167, tokenIndex167 := position, tokenIndex
+					position191, tokenIndex191 := position, tokenIndex
 					if !_rules[ruleCppType]() {
-						goto l167
+						goto l191
 					}
-					goto l168
-				l167:
-					position, tokenIndex = position167, tokenIndex167
+					goto l192
+				l191:
+					position, tokenIndex = position191, tokenIndex191
 				}
-			l168:
-				add(ruleListType, position166)
+			l192:
+				add(ruleListType, position190)
 			}
 			return true
-		l165:
-			position, tokenIndex = position165, tokenIndex165
+		l189:
+			position, tokenIndex = position189, tokenIndex189
 			return false
 		},
 		/* 30 CppType <- <(CPP_TYPE Literal)> */
 		func() bool {
-			position169, tokenIndex169 := position, tokenIndex
+			position193, tokenIndex193 := position, tokenIndex
 			{
-				position170 := position
+				position194 := position
 				if !_rules[ruleCPP_TYPE]() {
-					goto l169
+					goto l193
 				}
 				if !_rules[ruleLiteral]() {
-					goto l169
+					goto l193
+				}
+				add(ruleCppType, position194)
+			}
+			return true
+		l193:
+			position, tokenIndex = position193, tokenIndex193
+			return false
+		},
+		/* 31 Annotations <- <(LPAR Annotation* RPAR)> */
+		func() bool {
+			position195, tokenIndex195 := position, tokenIndex
+			{
+				position196 := position
+				if !_rules[ruleLPAR]() {
+					goto l195
+				}
+			l197:
+				{
+					position198, tokenIndex198 := position, tokenIndex
+					if !_rules[ruleAnnotation]() {
+						goto l198
+					}
+					goto l197
+				l198:
+					position, tokenIndex = position198, tokenIndex198
+				}
+				if !_rules[ruleRPAR]() {
+					goto l195
+				}
+				add(ruleAnnotations, position196)
+			}
+			return true
+		l195:
+			position, tokenIndex = position195, tokenIndex195
+			return false
+		},
+		/* 32 Annotation <- <(Identifier (EQUAL Literal)? ListSeparator?)> */
+		func() bool {
+			position199, tokenIndex199 := position, tokenIndex
+			{
+				position200 := position
+				if !_rules[ruleIdentifier]() {
+					goto l199
+				}
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					if !_rules[ruleEQUAL]() {
+						goto l201
+					}
+					if !_rules[ruleLiteral]() {
+						goto l201
+					}
+					goto l202
+				l201:
+					position, tokenIndex = position201, tokenIndex201
+				}
+			l202:
+				{
+					position203, tokenIndex203 := position, tokenIndex
+					if !_rules[ruleListSeparator]() {
+						goto l203
+					}
+					goto l204
+				l203:
+					position, tokenIndex = position203, tokenIndex203
 				}
-				add(ruleCppType, position170)
+			l204:
+				add(ruleAnnotation, position200)
 			}
 			return true
-		l169:
-			position, tokenIndex = position169, tokenIndex169
+		l199:
+			position, tokenIndex = position199, tokenIndex199
 			return false
 		},
-		/* 31 ConstValue <- <(DoubleConstant / IntConstant / Literal / Identifier / ConstList / ConstMap)> */
+		/* 33 ConstValue <- <(DoubleConstant / IntConstant / Literal / Identifier / ConstList / ConstMap)> */
 		func() bool {
-			position171, tokenIndex171 := position, tokenIndex
+			position205, tokenIndex205 := position, tokenIndex
 			{
-				position172 := position
+				position206 := position
 				{
-					position173, tokenIndex173 := position, tokenIndex
+					position207, tokenIndex207 := position, tokenIndex
 					if !_rules[ruleDoubleConstant]() {
-						goto l174
+						goto l208
 					}
-					goto l173
-				l174:
-					position, tokenIndex = position173, tokenIndex173
+					goto l207
+				l208:
+					position, tokenIndex = position207, tokenIndex207
 					if !_rules[ruleIntConstant]() {
-						goto l175
+						goto l209
 					}
-					goto l173
-				l175:
-					position, tokenIndex = position173, tokenIndex173
+					goto l207
+				l209:
+					position, tokenIndex = position207, tokenIndex207
 					if !_rules[ruleLiteral]() {
-						goto l176
+						goto l210
 					}
-					goto l173
-				l176:
-					position, tokenIndex = position173, tokenIndex173
+					goto l207
+				l210:
+					position, tokenIndex = position207, tokenIndex207
 					if !_rules[ruleIdentifier]() {
-						goto l177
+						goto l211
 					}
-					goto l173
-				l177:
-					position, tokenIndex = position173, tokenIndex173
+					goto l207
+				l211:
+					position, tokenIndex = position207, tokenIndex207
 					if !_rules[ruleConstList]() {
-						goto l178
+						goto l212
 					}
-					goto l173
-				l178:
-					position, tokenIndex = position173, tokenIndex173
+					goto l207
+				l212:
+					position, tokenIndex = position207, tokenIndex207
 					if !_rules[ruleConstMap]() {
-						goto l171
+						goto l205
 					}
 				}
-			l173:
-				add(ruleConstValue, position172)
+			l207:
+				add(ruleConstValue, position206)
 			}
 			return true
-		l171:
-			position, tokenIndex = position171, tokenIndex171
+		l205:
+			position, tokenIndex = position205, tokenIndex205
 			return false
 		},
-		/* 32 DoubleConstant <- <(<(('+' / '-')? ((Digit* '.' Digit+ Exponent?) / (Digit+ Exponent)))> Spacing)> */
+		/* 34 DoubleConstant <- <(<(('+' / '-')? ((Digit* '.' Digit+ Exponent?) / (Digit+ Exponent)))> Spacing)> */
 		func() bool {
-			position179, tokenIndex179 := position, tokenIndex
+			position213, tokenIndex213 := position, tokenIndex
 			{
-				position180 := position
+				position214 := position
 				{
-					position181 := position
+					position215 := position
 					{
-						position182, tokenIndex182 := position, tokenIndex
+						position216, tokenIndex216 := position, tokenIndex
 						{
-							position184, tokenIndex184 := position, tokenIndex
+							position218, tokenIndex218 := position, tokenIndex
 							if buffer[position] != rune('+') {
-								goto l185
+								goto l219
 							}
 							position++
-							goto l184
-						l185:
-							position, tokenIndex = position184, tokenIndex184
+							goto l218
+						l219:
+							position, tokenIndex = position218, tokenIndex218
 							if buffer[position] != rune('-') {
-								goto l182
+								goto l216
 							}
 							position++
 						}
-					l184:
-						goto l183
-					l182:
-						position, tokenIndex = position182, tokenIndex182
+					l218:
+						goto l217
+					l216:
+						position, tokenIndex = position216, tokenIndex216
 					}
-				l183:
+				l217:
 					{
-						position186, tokenIndex186 := position, tokenIndex
-					l188:
+						position220, tokenIndex220 := position, tokenIndex
+					l222:
 						{
-							position189, tokenIndex189 := position, tokenIndex
+							position223, tokenIndex223 := position, tokenIndex
 							if !_rules[ruleDigit]() {
-								goto l189
+								goto l223
 							}
-							goto l188
-						l189:
-							position, tokenIndex = position189, tokenIndex189
+							goto l222
+						l223:
+							position, tokenIndex = position223, tokenIndex223
 						}
 						if buffer[position] != rune('.') {
-							goto l187
+							goto l221
 						}
 						position++
 						if !_rules[ruleDigit]() {
-							goto l187
+							goto l221
 						}
-					l190:
+					l224:
 						{
-							position191, tokenIndex191 := position, tokenIndex
+							position225, tokenIndex225 := position, tokenIndex
 							if !_rules[ruleDigit]() {
-								goto l191
+								goto l225
 							}
-							goto l190
-						l191:
-							position, tokenIndex = position191, tokenIndex191
+							goto l224
+						l225:
+							position, tokenIndex = position225, tokenIndex225
 						}
 						{
-							position192, tokenIndex192 := position, tokenIndex
+							position226, tokenIndex226 := position, tokenIndex
 							if !_rules[ruleExponent]() {
-								goto l192
+								goto l226
 							}
-							goto l193
-						l192:
-							position, tokenIndex = position192, tokenIndex192
+							goto l227
+						l226:
+							position, tokenIndex = position226, tokenIndex226
 						}
-					l193:
-						goto l186
-					l187:
-						position, tokenIndex = position186, tokenIndex186
+					l227:
+						goto l220
+					l221:
+						position, tokenIndex = position220, tokenIndex220
 						if !_rules[ruleDigit]() {
-							goto l179
+							goto l213
 						}
-					l194:
+					l228:
 						{
-							position195, tokenIndex195 := position, tokenIndex
+							position229, tokenIndex229 := position, tokenIndex
 							if !_rules[ruleDigit]() {
-								goto l195
+								goto l229
 							}
-							goto l194
-						l195:
-							position, tokenIndex = position195, tokenIndex195
+							goto l228
+						l229:
+							position, tokenIndex = position229, tokenIndex229
 						}
 						if !_rules[ruleExponent]() {
-							goto l179
+							goto l213
 						}
 					}
-				l186:
-					add(rulePegText, position181)
+				l220:
+					add(rulePegText, position215)
 				}
 				if !_rules[ruleSpacing]() {
-					goto l179
+					goto l213
 				}
-				add(ruleDoubleConstant, position180)
+				add(ruleDoubleConstant, position214)
 			}
 			return true
-		l179:
-			position, tokenIndex = position179, tokenIndex179
+		l213:
+			position, tokenIndex = position213, tokenIndex213
 			return false
 		},
-		/* 33 Exponent <- <(('e' / 'E') ('+' / '-')? Digit+)> */
+		/* 35 Exponent <- <(('e' / 'E') ('+' / '-')? Digit+)> */
 		func() bool {
-			position196, tokenIndex196 := position, tokenIndex
+			position230, tokenIndex230 := position, tokenIndex
 			{
-				position197 := position
+				position231 := position
 				{
-					position198, tokenIndex198 := position, tokenIndex
+					position232, tokenIndex232 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l199
+						goto l233
 					}
 					position++
-					goto l198
-				l199:
-					position, tokenIndex = position198, tokenIndex198
+					goto l232
+				l233:
+					position, tokenIndex = position232, tokenIndex232
 					if buffer[position] != rune('E') {
-						goto l196
+						goto l230
 					}
 					position++
 				}
-			l198:
+			l232:
 				{
-					position200, tokenIndex200 := position, tokenIndex
+					position234, tokenIndex234 := position, tokenIndex
 					{
-						position202, tokenIndex202 := position, tokenIndex
+						position236, tokenIndex236 := position, tokenIndex
 						if buffer[position] != rune('+') {
-							goto l203
+							goto l237
 						}
 						position++
-						goto l202
-					l203:
-						position, tokenIndex = position202, tokenIndex202
+						goto l236
+					l237:
+						position, tokenIndex = position236, tokenIndex236
 						if buffer[position] != rune('-') {
-							goto l200
+							goto l234
 						}
 						position++
 					}
-				l202:
-					goto l201
-				l200:
-					position, tokenIndex = position200, tokenIndex200
+				l236:
+					goto l235
+				l234:
+					position, tokenIndex = position234, tokenIndex234
 				}
-			l201:
+			l235:
 				if !_rules[ruleDigit]() {
-					goto l196
+					goto l230
 				}
-			l204:
+			l238:
 				{
-					position205, tokenIndex205 := position, tokenIndex
+					position239, tokenIndex239 := position, tokenIndex
 					if !_rules[ruleDigit]() {
-						goto l205
+						goto l239
 					}
-					goto l204
-				l205:
-					position, tokenIndex = position205, tokenIndex205
+					goto l238
+				l239:
+					position, tokenIndex = position239, tokenIndex239
 				}
-				add(ruleExponent, position197)
+				add(ruleExponent, position231)
 			}
 			return true
-		l196:
-			position, tokenIndex = position196, tokenIndex196
+		l230:
+			position, tokenIndex = position230, tokenIndex230
 			return false
 		},
-		/* 34 IntConstant <- <(<(('+' / '-')? Digit+)> Spacing)> */
+		/* 36 IntConstant <- <(<(('+' / '-')? Digit+)> Spacing)> */
 		func() bool {
-			position206, tokenIndex206 := position, tokenIndex
+			position240, tokenIndex240 := position, tokenIndex
 			{
-				position207 := position
+				position241 := position
 				{
-					position208 := position
+					position242 := position
 					{
-						position209, tokenIndex209 := position, tokenIndex
+						position243, tokenIndex243 := position, tokenIndex
 						{
-							position211, tokenIndex211 := position, tokenIndex
+							position245, tokenIndex245 := position, tokenIndex
 							if buffer[position] != rune('+') {
-								goto l212
+								goto l246
 							}
 							position++
-							goto l211
-						l212:
-							position, tokenIndex = position211, tokenIndex211
+							goto l245
+						l246:
+							position, tokenIndex = position245, tokenIndex245
 							if buffer[position] != rune('-') {
-								goto l209
+								goto l243
 							}
 							position++
 						}
-					l211:
-						goto l210
-					l209:
-						position, tokenIndex = position209, tokenIndex209
+					l245:
+						goto l244
+					l243:
+						position, tokenIndex = position243, tokenIndex243
 					}
-				l210:
+				l244:
 					if !_rules[ruleDigit]() {
-						goto l206
+						goto l240
 					}
-				l213:
+				l247:
 					{
-						position214, tokenIndex214 := position, tokenIndex
+						position248, tokenIndex248 := position, tokenIndex
 						if !_rules[ruleDigit]() {
-							goto l214
+							goto l248
 						}
-						goto l213
-					l214:
-						position, tokenIndex = position214, tokenIndex214
+						goto l247
+					l248:
+						position, tokenIndex = position248, tokenIndex248
 					}
-					add(rulePegText, position208)
+					add(rulePegText, position242)
 				}
 				if !_rules[ruleSpacing]() {
-					goto l206
+					goto l240
 				}
-				add(ruleIntConstant, position207)
+				add(ruleIntConstant, position241)
 			}
 			return true
-		l206:
-			position, tokenIndex = position206, tokenIndex206
+		l240:
+			position, tokenIndex = position240, tokenIndex240
 			return false
 		},
-		/* 35 ConstList <- <(LBRK (ConstValue ListSeparator?)* RBRK)> */
+		/* 37 ConstList <- <(LBRK (ConstValue ListSeparator?)* RBRK)> */
 		func() bool {
-			position215, tokenIndex215 := position, tokenIndex
+			position249, tokenIndex249 := position, tokenIndex
 			{
-				position216 := position
+				position250 := position
 				if !_rules[ruleLBRK]() {
-					goto l215
+					goto l249
 				}
-			l217:
+			l251:
 				{
-					position218, tokenIndex218 := position, tokenIndex
+					position252, tokenIndex252 := position, tokenIndex
 					if !_rules[ruleConstValue]() {
-						goto l218
+						goto l252
 					}
 					{
-						position219, tokenIndex219 := position, tokenIndex
+						position253, tokenIndex253 := position, tokenIndex
 						if !_rules[ruleListSeparator]() {
-							goto l219
+							goto l253
 						}
-						goto l220
-					l219:
-						position, tokenIndex = position219, tokenIndex219
+						goto l254
+					l253:
+						position, tokenIndex = position253, tokenIndex253
 					}
-				l220:
-					goto l217
-				l218:
-					position, tokenIndex = position218, tokenIndex218
+				l254:
+					goto l251
+				l252:
+					position, tokenIndex = position252, tokenIndex252
 				}
 				if !_rules[ruleRBRK]() {
-					goto l215
+					goto l249
 				}
-				add(ruleConstList, position216)
+				add(ruleConstList, position250)
 			}
 			return true
-		l215:
-			position, tokenIndex = position215, tokenIndex215
+		l249:
+			position, tokenIndex = position249, tokenIndex249
 			return false
 		},
-		/* 36 ConstMap <- <(LWING (ConstValue COLON ConstValue ListSeparator?)* RWING)> */
+		/* 38 ConstMap <- <(LWING (ConstValue COLON ConstValue ListSeparator?)* RWING)> */
 		func() bool {
-			position221, tokenIndex221 := position, tokenIndex
+			position255, tokenIndex255 := position, tokenIndex
 			{
-				position222 := position
+				position256 := position
 				if !_rules[ruleLWING]() {
-					goto l221
+					goto l255
 				}
-			l223:
+			l257:
 				{
-					position224, tokenIndex224 := position, tokenIndex
+					position258, tokenIndex258 := position, tokenIndex
 					if !_rules[ruleConstValue]() {
-						goto l224
+						goto l258
 					}
 					if !_rules[ruleCOLON]() {
-						goto l224
+						goto l258
 					}
 					if !_rules[ruleConstValue]() {
-						goto l224
+						goto l258
 					}
 					{
-						position225, tokenIndex225 := position, tokenIndex
+						position259, tokenIndex259 := position, tokenIndex
 						if !_rules[ruleListSeparator]() {
-							goto l225
+							goto l259
 						}
-						goto l226
-					l225:
-						position, tokenIndex = position225, tokenIndex225
+						goto l260
+					l259:
+						position, tokenIndex = position259, tokenIndex259
 					}
-				l226:
-					goto l223
-				l224:
-					position, tokenIndex = position224, tokenIndex224
+				l260:
+					goto l257
+				l258:
+					position, tokenIndex = position258, tokenIndex258
 				}
 				if !_rules[ruleRWING]() {
-					goto l221
+					goto l255
 				}
-				add(ruleConstMap, position222)
+				add(ruleConstMap, position256)
 			}
 			return true
-		l221:
-			position, tokenIndex = position221, tokenIndex221
+		l255:
+			position, tokenIndex = position255, tokenIndex255
 			return false
 		},
-		/* 37 Literal <- <((('"' <(!'"' .)*> '"') / ('\'' <(!'\'' .)*> '\'')) Spacing)> */
+		/* 39 Literal <- <((('"' <(!'"' .)*> '"') / ('\'' <(!'\'' .)*> '\'')) Spacing)> */
 		func() bool {
-			position227, tokenIndex227 := position, tokenIndex
+			position261, tokenIndex261 := position, tokenIndex
 			{
-				position228 := position
+				position262 := position
 				{
-					position229, tokenIndex229 := position, tokenIndex
+					position263, tokenIndex263 := position, tokenIndex
 					if buffer[position] != rune('"') {
-						goto l230
+						goto l264
 					}
 					position++
 					{
-						position231 := position
-					l232:
+						position265 := position
+					l266:
 						{
-							position233, tokenIndex233 := position, tokenIndex
+							position267, tokenIndex267 := position, tokenIndex
 							{
-								position234, tokenIndex234 := position, tokenIndex
+								position268, tokenIndex268 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l234
+									goto l268
 								}
 								position++
-								goto l233
-							l234:
-								position, tokenIndex = position234, tokenIndex234
+								goto l267
+							l268:
+								position, tokenIndex = position268, tokenIndex268
 							}
 							if !matchDot() {
-								goto l233
+								goto l267
 							}
-							goto l232
-						l233:
-							position, tokenIndex = position233, tokenIndex233
+							goto l266
+						l267:
+							position, tokenIndex = position267, tokenIndex267
 						}
-						add(rulePegText, position231)
+						add(rulePegText, position265)
 					}
 					if buffer[position] != rune('"') {
-						goto l230
+						goto l264
 					}
 					position++
-					goto l229
-				l230:
-					position, tokenIndex = position229, tokenIndex229
+					goto l263
+				l264:
+					position, tokenIndex = position263, tokenIndex263
 					if buffer[position] != rune('\'') {
-						goto l227
+						goto l261
 					}
 					position++
 					{
-						position235 := position
-					l236:
+						position269 := position
+					l270:
 						{
-							position237, tokenIndex237 := position, tokenIndex
+							position271, tokenIndex271 := position, tokenIndex
 							{
-								position238, tokenIndex238 := position, tokenIndex
+								position272, tokenIndex272 := position, tokenIndex
 								if buffer[position] != rune('\'') {
-									goto l238
+									goto l272
 								}
 								position++
-								goto l237
-							l238:
-								position, tokenIndex = position238, tokenIndex238
+								goto l271
+							l272:
+								position, tokenIndex = position272, tokenIndex272
 							}
 							if !matchDot() {
-								goto l237
+								goto l271
 							}
-							goto l236
-						l237:
-							position, tokenIndex = position237, tokenIndex237
+							goto l270
+						l271:
+							position, tokenIndex = position271, tokenIndex271
 						}
-						add(rulePegText, position235)
+						add(rulePegText, position269)
 					}
 					if buffer[position] != rune('\'') {
-						goto l227
+						goto l261
 					}
 					position++
 				}
-			l229:
+			l263:
 				if !_rules[ruleSpacing]() {
-					goto l227
+					goto l261
 				}
-				add(ruleLiteral, position228)
+				add(ruleLiteral, position262)
 			}
 			return true
-		l227:
-			position, tokenIndex = position227, tokenIndex227
+		l261:
+			position, tokenIndex = position261, tokenIndex261
 			return false
 		},
-		/* 38 Identifier <- <(<((Letter / '_') (Letter / Digit / '.' / '_')*)> Spacing)> */
+		/* 40 Identifier <- <(<((Letter / '_') (Letter / Digit / '.' / '_')*)> Spacing)> */
 		func() bool {
-			position239, tokenIndex239 := position, tokenIndex
+			position273, tokenIndex273 := position, tokenIndex
 			{
-				position240 := position
+				position274 := position
 				{
-					position241 := position
+					position275 := position
 					{
-						position242, tokenIndex242 := position, tokenIndex
+						position276, tokenIndex276 := position, tokenIndex
 						if !_rules[ruleLetter]() {
-							goto l243
+							goto l277
 						}
-						goto l242
-					l243:
-						position, tokenIndex = position242, tokenIndex242
+						goto l276
+					l277:
+						position, tokenIndex = position276, tokenIndex276
 						if buffer[position] != rune('_') {
-							goto l239
+							goto l273
 						}
 						position++
 					}
-				l242:
-				l244:
+				l276:
+				l278:
 					{
-						position245, tokenIndex245 := position, tokenIndex
+						position279, tokenIndex279 := position, tokenIndex
 						{
-							position246, tokenIndex246 := position, tokenIndex
+							position280, tokenIndex280 := position, tokenIndex
 							if !_rules[ruleLetter]() {
-								goto l247
+								goto l281
 							}
-							goto l246
-						l247:
-							position, tokenIndex = position246, tokenIndex246
+							goto l280
+						l281:
+							position, tokenIndex = position280, tokenIndex280
 							if !_rules[ruleDigit]() {
-								goto l248
+								goto l282
 							}
-							goto l246
-						l248:
-							position, tokenIndex = position246, tokenIndex246
+							goto l280
+						l282:
+							position, tokenIndex = position280, tokenIndex280
 							if buffer[position] != rune('.') {
-								goto l249
+								goto l283
 							}
 							position++
-							goto l246
-						l249:
-							position, tokenIndex = position246, tokenIndex246
+							goto l280
+						l283:
+							position, tokenIndex = position280, tokenIndex280
 							if buffer[position] != rune('_') {
-								goto l245
+								goto l279
 							}
 							position++
 						}
-					l246:
-						goto l244
-					l245:
-						position, tokenIndex = position245, tokenIndex245
+					l280:
+						goto l278
+					l279:
+						position, tokenIndex = position279, tokenIndex279
 					}
-					add(rulePegText, position241)
+					add(rulePegText, position275)
 				}
 				if !_rules[ruleSpacing]() {
-					goto l239
+					goto l273
 				}
-				add(ruleIdentifier, position240)
+				add(ruleIdentifier, position274)
 			}
 			return true
-		l239:
-			position, tokenIndex = position239, tokenIndex239
+		l273:
+			position, tokenIndex = position273, tokenIndex273
 			return false
 		},
-		/* 39 STIdentifier <- <(<((Letter / '_') (Letter / Digit / '.' / '_' / '-')*)> Spacing)> */
+		/* 41 STIdentifier <- <(<((Letter / '_') (Letter / Digit / '.' / '_' / '-')*)> Spacing)> */
 		func() bool {
-			position250, tokenIndex250 := position, tokenIndex
+			position284, tokenIndex284 := position, tokenIndex
 			{
-				position251 := position
+				position285 := position
 				{
-					position252 := position
+					position286 := position
 					{
-						position253, tokenIndex253 := position, tokenIndex
+						position287, tokenIndex287 := position, tokenIndex
 						if !_rules[ruleLetter]() {
-							goto l254
+							goto l288
 						}
-						goto l253
-					l254:
-						position, tokenIndex = position253, tokenIndex253
+						goto l287
+					l288:
+						position, tokenIndex = position287, tokenIndex287
 						if buffer[position] != rune('_') {
-							goto l250
+							goto l284
 						}
 						position++
 					}
-				l253:
-				l255:
+				l287:
+				l289:
 					{
-						position256, tokenIndex256 := position, tokenIndex
+						position290, tokenIndex290 := position, tokenIndex
 						{
-							position257, tokenIndex257 := position, tokenIndex
+							position291, tokenIndex291 := position, tokenIndex
 							if !_rules[ruleLetter]() {
-								goto l258
+								goto l292
 							}
-							goto l257
-						l258:
-							position, tokenIndex = position257, tokenIndex257
+							goto l291
+						l292:
+							position, tokenIndex = position291, tokenIndex291
 							if !_rules[ruleDigit]() {
-								goto l259
+								goto l293
 							}
-							goto l257
-						l259:
-							position, tokenIndex = position257, tokenIndex257
+							goto l291
+						l293:
+							position, tokenIndex = position291, tokenIndex291
 							if buffer[position] != rune('.') {
-								goto l260
+								goto l294
 							}
 							position++
-							goto l257
-						l260:
-							position, tokenIndex = position257, tokenIndex257
+							goto l291
+						l294:
+							position, tokenIndex = position291, tokenIndex291
 							if buffer[position] != rune('_') {
-								goto l261
+								goto l295
 							}
 							position++
-							goto l257
-						l261:
-							position, tokenIndex = position257, tokenIndex257
+							goto l291
+						l295:
+							position, tokenIndex = position291, tokenIndex291
 							if buffer[position] != rune('-') {
-								goto l256
+								goto l290
 							}
 							position++
 						}
-					l257:
-						goto l255
-					l256:
-						position, tokenIndex = position256, tokenIndex256
+					l291:
+						goto l289
+					l290:
+						position, tokenIndex = position290, tokenIndex290
 					}
-					add(rulePegText, position252)
+					add(rulePegText, position286)
 				}
 				if !_rules[ruleSpacing]() {
-					goto l250
+					goto l284
 				}
-				add(ruleSTIdentifier, position251)
+				add(ruleSTIdentifier, position285)
 			}
 			return true
-		l250:
-			position, tokenIndex = position250, tokenIndex250
+		l284:
+			position, tokenIndex = position284, tokenIndex284
 			return false
 		},
-		/* 40 ListSeparator <- <((',' / ';') Spacing)> */
+		/* 42 ListSeparator <- <((',' / ';') Spacing)> */
 		func() bool {
-			position262, tokenIndex262 := position, tokenIndex
+			position296, tokenIndex296 := position, tokenIndex
 			{
-				position263 := position
+				position297 := position
 				{
-					position264, tokenIndex264 := position, tokenIndex
+					position298, tokenIndex298 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l265
+						goto l299
 					}
 					position++
-					goto l264
-				l265:
-					position, tokenIndex = position264, tokenIndex264
+					goto l298
+				l299:
+					position, tokenIndex = position298, tokenIndex298
 					if buffer[position] != rune(';') {
-						goto l262
+						goto l296
 					}
 					position++
 				}
-			l264:
+			l298:
 				if !_rules[ruleSpacing]() {
-					goto l262
+					goto l296
 				}
-				add(ruleListSeparator, position263)
+				add(ruleListSeparator, position297)
 			}
 			return true
-		l262:
-			position, tokenIndex = position262, tokenIndex262
+		l296:
+			position, tokenIndex = position296, tokenIndex296
 			return false
 		},
-		/* 41 Letter <- <([a-z] / [A-Z])> */
+		/* 43 Letter <- <([a-z] / [A-Z])> */
 		func() bool {
-			position266, tokenIndex266 := position, tokenIndex
+			position300, tokenIndex300 := position, tokenIndex
 			{
-				position267 := position
+				position301 := position
 				{
-					position268, tokenIndex268 := position, tokenIndex
+					position302, tokenIndex302 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l269
+						goto l303
 					}
 					position++
-					goto l268
-				l269:
-					position, tokenIndex = position268, tokenIndex268
+					goto l302
+				l303:
+					position, tokenIndex = position302, tokenIndex302
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l266
+						goto l300
 					}
 					position++
 				}
-			l268:
-				add(ruleLetter, position267)
+			l302:
+				add(ruleLetter, position301)
 			}
 			return true
-		l266:
-			position, tokenIndex = position266, tokenIndex266
+		l300:
+			position, tokenIndex = position300, tokenIndex300
 			return false
 		},
-		/* 42 Digit <- <[0-9]> */
+		/* 44 Digit <- <[0-9]> */
 		func() bool {
-			position270, tokenIndex270 := position, tokenIndex
+			position304, tokenIndex304 := position, tokenIndex
 			{
-				position271 := position
+				position305 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l270
+					goto l304
 				}
 				position++
-				add(ruleDigit, position271)
+				add(ruleDigit, position305)
 			}
 			return true
-		l270:
-			position, tokenIndex = position270, tokenIndex270
+		l304:
+			position, tokenIndex = position304, tokenIndex304
 			return false
 		},
-		/* 43 IdChars <- <([a-z] / [A-Z] / [0-9] / ('_' / '$'))> */
+		/* 45 IdChars <- <([a-z] / [A-Z] / [0-9] / ('_' / '$'))> */
 		func() bool {
-			position272, tokenIndex272 := position, tokenIndex
+			position306, tokenIndex306 := position, tokenIndex
 			{
-				position273 := position
+				position307 := position
 				{
-					position274, tokenIndex274 := position, tokenIndex
+					position308, tokenIndex308 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l275
+						goto l309
 					}
 					position++
-					goto l274
-				l275:
-					position, tokenIndex = position274, tokenIndex274
+					goto l308
+				l309:
+					position, tokenIndex = position308, tokenIndex308
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l276
+						goto l310
 					}
 					position++
-					goto l274
-				l276:
-					position, tokenIndex = position274, tokenIndex274
+					goto l308
+				l310:
+					position, tokenIndex = position308, tokenIndex308
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l277
+						goto l311
 					}
 					position++
-					goto l274
-				l277:
-					position, tokenIndex = position274, tokenIndex274
+					goto l308
+				l311:
+					position, tokenIndex = position308, tokenIndex308
 					{
-						position278, tokenIndex278 := position, tokenIndex
+						position312, tokenIndex312 := position, tokenIndex
 						if buffer[position] != rune('_') {
-							goto l279
+							goto l313
 						}
 						position++
-						goto l278
-					l279:
-						position, tokenIndex = position278, tokenIndex278
+						goto l312
+					l313:
+						position, tokenIndex = position312, tokenIndex312
 						if buffer[position] != rune('$') {
-							goto l272
+							goto l306
 						}
 						position++
 					}
-				l278:
+				l312:
 				}
-			l274:
-				add(ruleIdChars, position273)
+			l308:
+				add(ruleIdChars, position307)
 			}
 			return true
-		l272:
-			position, tokenIndex = position272, tokenIndex272
+		l306:
+			position, tokenIndex = position306, tokenIndex306
 			return false
 		},
-		/* 44 Spacing <- <(Whitespace / LongComment / LineComment / Pragma)*> */
+		/* 46 Spacing <- <(Whitespace / LongComment / LineComment / Pragma)*> */
 		func() bool {
 			{
-				position281 := position
-			l282:
+				position315 := position
+			l316:
 				{
-					position283, tokenIndex283 := position, tokenIndex
+					position317, tokenIndex317 := position, tokenIndex
 					{
-						position284, tokenIndex284 := position, tokenIndex
+						position318, tokenIndex318 := position, tokenIndex
 						if !_rules[ruleWhitespace]() {
-							goto l285
+							goto l319
 						}
-						goto l284
-					l285:
-						position, tokenIndex = position284, tokenIndex284
+						goto l318
+					l319:
+						position, tokenIndex = position318, tokenIndex318
 						if !_rules[ruleLongComment]() {
-							goto l286
+							goto l320
 						}
-						goto l284
-					l286:
-						position, tokenIndex = position284, tokenIndex284
+						goto l318
+					l320:
+						position, tokenIndex = position318, tokenIndex318
 						if !_rules[ruleLineComment]() {
-							goto l287
+							goto l321
 						}
-						goto l284
-					l287:
-						position, tokenIndex = position284, tokenIndex284
+						goto l318
+					l321:
+						position, tokenIndex = position318, tokenIndex318
 						if !_rules[rulePragma]() {
-							goto l283
+							goto l317
 						}
 					}
-				l284:
-					goto l282
-				l283:
-					position, tokenIndex = position283, tokenIndex283
+				l318:
+					goto l316
+				l317:
+					position, tokenIndex = position317, tokenIndex317
 				}
-				add(ruleSpacing, position281)
+				add(ruleSpacing, position315)
 			}
 			return true
 		},
-		/* 45 Whitespace <- <(' ' / '\t' / '\r' / '\n')+> */
+		/* 47 Whitespace <- <(' ' / '\t' / '\r' / '\n')+> */
 		func() bool {
-			position288, tokenIndex288 := position, tokenIndex
+			position322, tokenIndex322 := position, tokenIndex
 			{
-				position289 := position
+				position323 := position
 				{
-					position292, tokenIndex292 := position, tokenIndex
+					position326, tokenIndex326 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l293
+						goto l327
 					}
 					position++
-					goto l292
-				l293:
-					position, tokenIndex = position292, tokenIndex292
+					goto l326
+				l327:
+					position, tokenIndex = position326, tokenIndex326
 					if buffer[position] != rune('\t') {
-						goto l294
+						goto l328
 					}
 					position++
-					goto l292
-				l294:
-					position, tokenIndex = position292, tokenIndex292
+					goto l326
+				l328:
+					position, tokenIndex = position326, tokenIndex326
 					if buffer[position] != rune('\r') {
-						goto l295
+						goto l329
 					}
 					position++
-					goto l292
-				l295:
-					position, tokenIndex = position292, tokenIndex292
+					goto l326
+				l329:
+					position, tokenIndex = position326, tokenIndex326
 					if buffer[position] != rune('\n') {
-						goto l288
+						goto l322
 					}
 					position++
 				}
-			l292:
-			l290:
+			l326:
+			l324:
 				{
-					position291, tokenIndex291 := position, tokenIndex
+					position325, tokenIndex325 := position, tokenIndex
 					{
-						position296, tokenIndex296 := position, tokenIndex
+						position330, tokenIndex330 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l297
+							goto l331
 						}
 						position++
-						goto l296
-					l297:
-						position, tokenIndex = position296, tokenIndex296
+						goto l330
+					l331:
+						position, tokenIndex = position330, tokenIndex330
 						if buffer[position] != rune('\t') {
-							goto l298
+							goto l332
 						}
 						position++
-						goto l296
-					l298:
-						position, tokenIndex = position296, tokenIndex296
+						goto l330
+					l332:
+						position, tokenIndex = position330, tokenIndex330
 						if buffer[position] != rune('\r') {
-							goto l299
+							goto l333
 						}
 						position++
-						goto l296
-					l299:
-						position, tokenIndex = position296, tokenIndex296
+						goto l330
+					l333:
+						position, tokenIndex = position330, tokenIndex330
 						if buffer[position] != rune('\n') {
-							goto l291
+							goto l325
 						}
 						position++
 					}
-				l296:
-					goto l290
-				l291:
-					position, tokenIndex = position291, tokenIndex291
+				l330:
+					goto l324
+				l325:
+					position, tokenIndex = position325, tokenIndex325
 				}
-				add(ruleWhitespace, position289)
+				add(ruleWhitespace, position323)
 			}
 			return true
-		l288:
-			position, tokenIndex = position288, tokenIndex288
+		l322:
+			position, tokenIndex = position322, tokenIndex322
 			return false
 		},
-		/* 46 LongComment <- <('/' '*' (!('*' '/') .)* ('*' '/'))> */
+		/* 48 LongComment <- <('/' '*' (!('*' '/') .)* ('*' '/'))> */
 		func() bool {
-			position300, tokenIndex300 := position, tokenIndex
+			position334, tokenIndex334 := position, tokenIndex
 			{
-				position301 := position
+				position335 := position
 				if buffer[position] != rune('/') {
-					goto l300
+					goto l334
 				}
 				position++
 				if buffer[position] != rune('*') {
-					goto l300
+					goto l334
 				}
 				position++
-			l302:
+			l336:
 				{
-					position303, tokenIndex303 := position, tokenIndex
+					position337, tokenIndex337 := position, tokenIndex
 					{
-						position304, tokenIndex304 := position, tokenIndex
+						position338, tokenIndex338 := position, tokenIndex
 						if buffer[position] != rune('*') {
-							goto l304
+							goto l338
 						}
 						position++
 						if buffer[position] != rune('/') {
-							goto l304
+							goto l338
 						}
 						position++
-						goto l303
-					l304:
-						position, tokenIndex = position304, tokenIndex304
+						goto l337
+					l338:
+						position, tokenIndex = position338, tokenIndex338
 					}
 					if !matchDot() {
-						goto l303
+						goto l337
 					}
-					goto l302
-				l303:
-					position, tokenIndex = position303, tokenIndex303
+					goto l336
+				l337:
+					position, tokenIndex = position337, tokenIndex337
 				}
 				if buffer[position] != rune('*') {
-					goto l300
+					goto l334
 				}
 				position++
 				if buffer[position] != rune('/') {
-					goto l300
+					goto l334
 				}
 				position++
-				add(ruleLongComment, position301)
+				add(ruleLongComment, position335)
 			}
 			return true
-		l300:
-			position, tokenIndex = position300, tokenIndex300
+		l334:
+			position, tokenIndex = position334, tokenIndex334
 			return false
 		},
-		/* 47 LineComment <- <('/' '/' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 49 LineComment <- <('/' '/' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position305, tokenIndex305 := position, tokenIndex
+			position339, tokenIndex339 := position, tokenIndex
 			{
-				position306 := position
+				position340 := position
 				if buffer[position] != rune('/') {
-					goto l305
+					goto l339
 				}
 				position++
 				if buffer[position] != rune('/') {
-					goto l305
+					goto l339
 				}
 				position++
-			l307:
+			l341:
 				{
-					position308, tokenIndex308 := position, tokenIndex
+					position342, tokenIndex342 := position, tokenIndex
 					{
-						position309, tokenIndex309 := position, tokenIndex
+						position343, tokenIndex343 := position, tokenIndex
 						{
-							position310, tokenIndex310 := position, tokenIndex
+							position344, tokenIndex344 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l311
+								goto l345
 							}
 							position++
-							goto l310
-						l311:
-							position, tokenIndex = position310, tokenIndex310
+							goto l344
+						l345:
+							position, tokenIndex = position344, tokenIndex344
 							if buffer[position] != rune('\n') {
-								goto l309
+								goto l343
 							}
 							position++
 						}
-					l310:
-						goto l308
-					l309:
-						position, tokenIndex = position309, tokenIndex309
+					l344:
+						goto l342
+					l343:
+						position, tokenIndex = position343, tokenIndex343
 					}
 					if !matchDot() {
-						goto l308
+						goto l342
 					}
-					goto l307
-				l308:
-					position, tokenIndex = position308, tokenIndex308
+					goto l341
+				l342:
+					position, tokenIndex = position342, tokenIndex342
 				}
 				{
-					position312, tokenIndex312 := position, tokenIndex
+					position346, tokenIndex346 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l313
+						goto l347
 					}
 					position++
-					goto l312
-				l313:
-					position, tokenIndex = position312, tokenIndex312
+					goto l346
+				l347:
+					position, tokenIndex = position346, tokenIndex346
 					if buffer[position] != rune('\n') {
-						goto l305
+						goto l339
 					}
 					position++
 				}
-			l312:
-				add(ruleLineComment, position306)
+			l346:
+				add(ruleLineComment, position340)
 			}
 			return true
-		l305:
-			position, tokenIndex = position305, tokenIndex305
+		l339:
+			position, tokenIndex = position339, tokenIndex339
 			return false
 		},
-		/* 48 Pragma <- <('#' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 50 Pragma <- <('#' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position314, tokenIndex314 := position, tokenIndex
+			position348, tokenIndex348 := position, tokenIndex
 			{
-				position315 := position
+				position349 := position
 				if buffer[position] != rune('#') {
-					goto l314
+					goto l348
 				}
 				position++
-			l316:
+			l350:
 				{
-					position317, tokenIndex317 := position, tokenIndex
+					position351, tokenIndex351 := position, tokenIndex
 					{
-						position318, tokenIndex318 := position, tokenIndex
+						position352, tokenIndex352 := position, tokenIndex
 						{
-							position319, tokenIndex319 := position, tokenIndex
+							position353, tokenIndex353 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l320
+								goto l354
 							}
 							position++
-							goto l319
-						l320:
-							position, tokenIndex = position319, tokenIndex319
+							goto l353
+						l354:
+							position, tokenIndex = position353, tokenIndex353
 							if buffer[position] != rune('\n') {
-								goto l318
+								goto l352
 							}
 							position++
 						}
-					l319:
-						goto l317
-					l318:
-						position, tokenIndex = position318, tokenIndex318
+					l353:
+						goto l351
+					l352:
+						position, tokenIndex = position352, tokenIndex352
 					}
 					if !matchDot() {
-						goto l317
+						goto l351
 					}
-					goto l316
-				l317:
-					position, tokenIndex = position317, tokenIndex317
+					goto l350
+				l351:
+					position, tokenIndex = position351, tokenIndex351
 				}
 				{
-					position321, tokenIndex321 := position, tokenIndex
+					position355, tokenIndex355 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l322
+						goto l356
 					}
 					position++
-					goto l321
-				l322:
-					position, tokenIndex = position321, tokenIndex321
+					goto l355
+				l356:
+					position, tokenIndex = position355, tokenIndex355
 					if buffer[position] != rune('\n') {
-						goto l314
+						goto l348
 					}
 					position++
 				}
-			l321:
-				add(rulePragma, position315)
+			l355:
+				add(rulePragma, position349)
 			}
 			return true
-		l314:
-			position, tokenIndex = position314, tokenIndex314
+		l348:
+			position, tokenIndex = position348, tokenIndex348
 			return false
 		},
-		/* 49 INCLUDE <- <('i' 'n' 'c' 'l' 'u' 'd' 'e' !IdChars Spacing)> */
+		/* 51 INCLUDE <- <('i' 'n' 'c' 'l' 'u' 'd' 'e' !IdChars Spacing)> */
 		func() bool {
-			position323, tokenIndex323 := position, tokenIndex
+			position357, tokenIndex357 := position, tokenIndex
 			{
-				position324 := position
+				position358 := position
 				if buffer[position] != rune('i') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l323
+					goto l357
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l323
+					goto l357
 				}
 				position++
 				{
-					position325, tokenIndex325 := position, tokenIndex
+					position359, tokenIndex359 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l325
+						goto l359
 					}
-					goto l323
-				l325:
-					position, tokenIndex = position325, tokenIndex325
+					goto l357
+				l359:
+					position, tokenIndex = position359, tokenIndex359
 				}
 				if !_rules[ruleSpacing]() {
-					goto l323
+					goto l357
 				}
-				add(ruleINCLUDE, position324)
+				add(ruleINCLUDE, position358)
 			}
 			return true
-		l323:
-			position, tokenIndex = position323, tokenIndex323
+		l357:
+			position, tokenIndex = position357, tokenIndex357
 			return false
 		},
-		/* 50 CPP_INCLUDE <- <('c' 'p' 'p' '_' 'i' 'n' 'c' 'l' 'u' 'd' 'e' !IdChars Spacing)> */
+		/* 52 CPP_INCLUDE <- <('c' 'p' 'p' '_' 'i' 'n' 'c' 'l' 'u' 'd' 'e' !IdChars Spacing)> */
 		func() bool {
-			position326, tokenIndex326 := position, tokenIndex
+			position360, tokenIndex360 := position, tokenIndex
 			{
-				position327 := position
+				position361 := position
 				if buffer[position] != rune('c') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l326
+					goto l360
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l326
+					goto l360
 				}
 				position++
 				{
-					position328, tokenIndex328 := position, tokenIndex
+					position362, tokenIndex362 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l328
+						goto l362
 					}
-					goto l326
-				l328:
-					position, tokenIndex = position328, tokenIndex328
+					goto l360
+				l362:
+					position, tokenIndex = position362, tokenIndex362
 				}
 				if !_rules[ruleSpacing]() {
-					goto l326
+					goto l360
 				}
-				add(ruleCPP_INCLUDE, position327)
+				add(ruleCPP_INCLUDE, position361)
 			}
 			return true
-		l326:
-			position, tokenIndex = position326, tokenIndex326
+		l360:
+			position, tokenIndex = position360, tokenIndex360
 			return false
 		},
-		/* 51 NAMESPACE <- <('n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
+		/* 53 NAMESPACE <- <('n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
 		func() bool {
-			position329, tokenIndex329 := position, tokenIndex
+			position363, tokenIndex363 := position, tokenIndex
 			{
-				position330 := position
+				position364 := position
 				if buffer[position] != rune('n') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l329
+					goto l363
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l329
+					goto l363
 				}
 				position++
 				{
-					position331, tokenIndex331 := position, tokenIndex
+					position365, tokenIndex365 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l331
+						goto l365
 					}
-					goto l329
-				l331:
-					position, tokenIndex = position331, tokenIndex331
+					goto l363
+				l365:
+					position, tokenIndex = position365, tokenIndex365
 				}
 				if !_rules[ruleSpacing]() {
-					goto l329
+					goto l363
 				}
-				add(ruleNAMESPACE, position330)
+				add(ruleNAMESPACE, position364)
 			}
 			return true
-		l329:
-			position, tokenIndex = position329, tokenIndex329
+		l363:
+			position, tokenIndex = position363, tokenIndex363
 			return false
 		},
-		/* 52 SMALLTALK_CATEGORY <- <('s' 'm' 'a' 'l' 'l' 't' 'a' 'l' 'k' '.' 'c' 'a' 't' 'e' 'g' 'o' 'r' 'y' !IdChars Spacing)> */
+		/* 54 SMALLTALK_CATEGORY <- <('s' 'm' 'a' 'l' 'l' 't' 'a' 'l' 'k' '.' 'c' 'a' 't' 'e' 'g' 'o' 'r' 'y' !IdChars Spacing)> */
 		func() bool {
-			position332, tokenIndex332 := position, tokenIndex
+			position366, tokenIndex366 := position, tokenIndex
 			{
-				position333 := position
+				position367 := position
 				if buffer[position] != rune('s') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('k') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('g') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l332
+					goto l366
 				}
 				position++
 				if buffer[position] != rune('y') {
-					goto l332
+					goto l366
 				}
 				position++
 				{
-					position334, tokenIndex334 := position, tokenIndex
+					position368, tokenIndex368 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l334
+						goto l368
 					}
-					goto l332
-				l334:
-					position, tokenIndex = position334, tokenIndex334
+					goto l366
+				l368:
+					position, tokenIndex = position368, tokenIndex368
 				}
 				if !_rules[ruleSpacing]() {
-					goto l332
+					goto l366
 				}
-				add(ruleSMALLTALK_CATEGORY, position333)
+				add(ruleSMALLTALK_CATEGORY, position367)
 			}
 			return true
-		l332:
-			position, tokenIndex = position332, tokenIndex332
+		l366:
+			position, tokenIndex = position366, tokenIndex366
 			return false
 		},
-		/* 53 SMALLTALK_PREFIX <- <('s' 'm' 'a' 'l' 'l' 't' 'a' 'l' 'k' '.' 'p' 'r' 'e' 'f' 'i' 'x' !IdChars Spacing)> */
+		/* 55 SMALLTALK_PREFIX <- <('s' 'm' 'a' 'l' 'l' 't' 'a' 'l' 'k' '.' 'p' 'r' 'e' 'f' 'i' 'x' !IdChars Spacing)> */
 		func() bool {
-			position335, tokenIndex335 := position, tokenIndex
+			position369, tokenIndex369 := position, tokenIndex
 			{
-				position336 := position
+				position370 := position
 				if buffer[position] != rune('s') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('k') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('f') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l335
+					goto l369
 				}
 				position++
 				if buffer[position] != rune('x') {
-					goto l335
+					goto l369
 				}
 				position++
 				{
-					position337, tokenIndex337 := position, tokenIndex
+					position371, tokenIndex371 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l337
+						goto l371
 					}
-					goto l335
-				l337:
-					position, tokenIndex = position337, tokenIndex337
+					goto l369
+				l371:
+					position, tokenIndex = position371, tokenIndex371
 				}
 				if !_rules[ruleSpacing]() {
-					goto l335
+					goto l369
 				}
-				add(ruleSMALLTALK_PREFIX, position336)
+				add(ruleSMALLTALK_PREFIX, position370)
 			}
 			return true
-		l335:
-			position, tokenIndex = position335, tokenIndex335
+		l369:
+			position, tokenIndex = position369, tokenIndex369
 			return false
 		},
-		/* 54 PHP_NAMESPACE <- <('p' 'h' 'p' '_' 'n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
+		/* 56 PHP_NAMESPACE <- <('p' 'h' 'p' '_' 'n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
 		func() bool {
-			position338, tokenIndex338 := position, tokenIndex
+			position372, tokenIndex372 := position, tokenIndex
 			{
-				position339 := position
+				position373 := position
 				if buffer[position] != rune('p') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l338
+					goto l372
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l338
+					goto l372
 				}
 				position++
 				{
-					position340, tokenIndex340 := position, tokenIndex
+					position374, tokenIndex374 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l340
+						goto l374
 					}
-					goto l338
-				l340:
-					position, tokenIndex = position340, tokenIndex340
+					goto l372
+				l374:
+					position, tokenIndex = position374, tokenIndex374
 				}
 				if !_rules[ruleSpacing]() {
-					goto l338
+					goto l372
 				}
-				add(rulePHP_NAMESPACE, position339)
+				add(rulePHP_NAMESPACE, position373)
 			}
 			return true
-		l338:
-			position, tokenIndex = position338, tokenIndex338
+		l372:
+			position, tokenIndex = position372, tokenIndex372
 			return false
 		},
-		/* 55 XSD_NAMESPACE <- <('x' 's' 'd' '_' 'n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
+		/* 57 XSD_NAMESPACE <- <('x' 's' 'd' '_' 'n' 'a' 'm' 'e' 's' 'p' 'a' 'c' 'e' !IdChars Spacing)> */
 		func() bool {
-			position341, tokenIndex341 := position, tokenIndex
+			position375, tokenIndex375 := position, tokenIndex
 			{
-				position342 := position
+				position376 := position
 				if buffer[position] != rune('x') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l341
+					goto l375
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l341
+					goto l375
 				}
 				position++
 				{
-					position343, tokenIndex343 := position, tokenIndex
+					position377, tokenIndex377 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l343
+						goto l377
 					}
-					goto l341
-				l343:
-					position, tokenIndex = position343, tokenIndex343
+					goto l375
+				l377:
+					position, tokenIndex = position377, tokenIndex377
 				}
 				if !_rules[ruleSpacing]() {
-					goto l341
+					goto l375
 				}
-				add(ruleXSD_NAMESPACE, position342)
+				add(ruleXSD_NAMESPACE, position376)
 			}
 			return true
-		l341:
-			position, tokenIndex = position341, tokenIndex341
+		l375:
+			position, tokenIndex = position375, tokenIndex375
 			return false
 		},
-		/* 56 CONST <- <('c' 'o' 'n' 's' 't' !IdChars Spacing)> */
+		/* 58 CONST <- <('c' 'o' 'n' 's' 't' !IdChars Spacing)> */
 		func() bool {
-			position344, tokenIndex344 := position, tokenIndex
+			position378, tokenIndex378 := position, tokenIndex
 			{
-				position345 := position
+				position379 := position
 				if buffer[position] != rune('c') {
-					goto l344
+					goto l378
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l344
+					goto l378
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l344
+					goto l378
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l344
+					goto l378
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l344
+					goto l378
 				}
 				position++
 				{
-					position346, tokenIndex346 := position, tokenIndex
+					position380, tokenIndex380 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l346
+						goto l380
 					}
-					goto l344
-				l346:
-					position, tokenIndex = position346, tokenIndex346
+					goto l378
+				l380:
+					position, tokenIndex = position380, tokenIndex380
 				}
 				if !_rules[ruleSpacing]() {
-					goto l344
+					goto l378
 				}
-				add(ruleCONST, position345)
+				add(ruleCONST, position379)
 			}
 			return true
-		l344:
-			position, tokenIndex = position344, tokenIndex344
+		l378:
+			position, tokenIndex = position378, tokenIndex378
 			return false
 		},
-		/* 57 TYPEDEF <- <('t' 'y' 'p' 'e' 'd' 'e' 'f' !IdChars Spacing)> */
+		/* 59 TYPEDEF <- <('t' 'y' 'p' 'e' 'd' 'e' 'f' !IdChars Spacing)> */
 		func() bool {
-			position347, tokenIndex347 := position, tokenIndex
+			position381, tokenIndex381 := position, tokenIndex
 			{
-				position348 := position
+				position382 := position
 				if buffer[position] != rune('t') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('y') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l347
+					goto l381
 				}
 				position++
 				if buffer[position] != rune('f') {
-					goto l347
+					goto l381
 				}
 				position++
 				{
-					position349, tokenIndex349 := position, tokenIndex
+					position383, tokenIndex383 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l349
+						goto l383
 					}
-					goto l347
-				l349:
-					position, tokenIndex = position349, tokenIndex349
+					goto l381
+				l383:
+					position, tokenIndex = position383, tokenIndex383
 				}
 				if !_rules[ruleSpacing]() {
-					goto l347
+					goto l381
 				}
-				add(ruleTYPEDEF, position348)
+				add(ruleTYPEDEF, position382)
 			}
 			return true
-		l347:
-			position, tokenIndex = position347, tokenIndex347
+		l381:
+			position, tokenIndex = position381, tokenIndex381
 			return false
 		},
-		/* 58 ENUM <- <('e' 'n' 'u' 'm' !IdChars Spacing)> */
+		/* 60 ENUM <- <('e' 'n' 'u' 'm' !IdChars Spacing)> */
 		func() bool {
-			position350, tokenIndex350 := position, tokenIndex
+			position384, tokenIndex384 := position, tokenIndex
 			{
-				position351 := position
+				position385 := position
 				if buffer[position] != rune('e') {
-					goto l350
+					goto l384
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l350
+					goto l384
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l350
+					goto l384
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l350
+					goto l384
 				}
 				position++
 				{
-					position352, tokenIndex352 := position, tokenIndex
+					position386, tokenIndex386 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l352
+						goto l386
 					}
-					goto l350
-				l352:
-					position, tokenIndex = position352, tokenIndex352
+					goto l384
+				l386:
+					position, tokenIndex = position386, tokenIndex386
 				}
 				if !_rules[ruleSpacing]() {
-					goto l350
+					goto l384
 				}
-				add(ruleENUM, position351)
+				add(ruleENUM, position385)
 			}
 			return true
-		l350:
-			position, tokenIndex = position350, tokenIndex350
+		l384:
+			position, tokenIndex = position384, tokenIndex384
 			return false
 		},
-		/* 59 SENUM <- <('s' 'e' 'n' 'u' 'm' !IdChars Spacing)> */
+		/* 61 SENUM <- <('s' 'e' 'n' 'u' 'm' !IdChars Spacing)> */
 		func() bool {
-			position353, tokenIndex353 := position, tokenIndex
+			position387, tokenIndex387 := position, tokenIndex
 			{
-				position354 := position
+				position388 := position
 				if buffer[position] != rune('s') {
-					goto l353
+					goto l387
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l353
+					goto l387
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l353
+					goto l387
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l353
+					goto l387
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l353
+					goto l387
 				}
 				position++
 				{
-					position355, tokenIndex355 := position, tokenIndex
+					position389, tokenIndex389 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l355
+						goto l389
 					}
-					goto l353
-				l355:
-					position, tokenIndex = position355, tokenIndex355
+					goto l387
+				l389:
+					position, tokenIndex = position389, tokenIndex389
 				}
 				if !_rules[ruleSpacing]() {
-					goto l353
+					goto l387
 				}
-				add(ruleSENUM, position354)
+				add(ruleSENUM, position388)
 			}
 			return true
-		l353:
-			position, tokenIndex = position353, tokenIndex353
+		l387:
+			position, tokenIndex = position387, tokenIndex387
 			return false
 		},
-		/* 60 STRUCT <- <('s' 't' 'r' 'u' 'c' 't' !IdChars Spacing)> */
+		/* 62 STRUCT <- <('s' 't' 'r' 'u' 'c' 't' !IdChars Spacing)> */
 		func() bool {
-			position356, tokenIndex356 := position, tokenIndex
+			position390, tokenIndex390 := position, tokenIndex
 			{
-				position357 := position
+				position391 := position
 				if buffer[position] != rune('s') {
-					goto l356
+					goto l390
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l356
+					goto l390
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l356
+					goto l390
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l356
+					goto l390
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l356
+					goto l390
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l356
+					goto l390
 				}
 				position++
 				{
-					position358, tokenIndex358 := position, tokenIndex
+					position392, tokenIndex392 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l358
+						goto l392
 					}
-					goto l356
-				l358:
-					position, tokenIndex = position358, tokenIndex358
+					goto l390
+				l392:
+					position, tokenIndex = position392, tokenIndex392
 				}
 				if !_rules[ruleSpacing]() {
-					goto l356
+					goto l390
 				}
-				add(ruleSTRUCT, position357)
+				add(ruleSTRUCT, position391)
 			}
 			return true
-		l356:
-			position, tokenIndex = position356, tokenIndex356
+		l390:
+			position, tokenIndex = position390, tokenIndex390
 			return false
 		},
-		/* 61 UNION <- <('u' 'n' 'i' 'o' 'n' !IdChars Spacing)> */
+		/* 63 UNION <- <('u' 'n' 'i' 'o' 'n' !IdChars Spacing)> */
 		func() bool {
-			position359, tokenIndex359 := position, tokenIndex
+			position393, tokenIndex393 := position, tokenIndex
 			{
-				position360 := position
+				position394 := position
 				if buffer[position] != rune('u') {
-					goto l359
+					goto l393
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l359
+					goto l393
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l359
+					goto l393
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l359
+					goto l393
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l359
+					goto l393
 				}
 				position++
 				{
-					position361, tokenIndex361 := position, tokenIndex
+					position395, tokenIndex395 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l361
+						goto l395
 					}
-					goto l359
-				l361:
-					position, tokenIndex = position361, tokenIndex361
+					goto l393
+				l395:
+					position, tokenIndex = position395, tokenIndex395
 				}
 				if !_rules[ruleSpacing]() {
-					goto l359
+					goto l393
 				}
-				add(ruleUNION, position360)
+				add(ruleUNION, position394)
 			}
 			return true
-		l359:
-			position, tokenIndex = position359, tokenIndex359
+		l393:
+			position, tokenIndex = position393, tokenIndex393
 			return false
 		},
-		/* 62 SERVICE <- <('s' 'e' 'r' 'v' 'i' 'c' 'e' !IdChars Spacing)> */
+		/* 64 SERVICE <- <('s' 'e' 'r' 'v' 'i' 'c' 'e' !IdChars Spacing)> */
 		func() bool {
-			position362, tokenIndex362 := position, tokenIndex
+			position396, tokenIndex396 := position, tokenIndex
 			{
-				position363 := position
+				position397 := position
 				if buffer[position] != rune('s') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('v') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l362
+					goto l396
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l362
+					goto l396
 				}
 				position++
 				{
-					position364, tokenIndex364 := position, tokenIndex
+					position398, tokenIndex398 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l364
+						goto l398
 					}
-					goto l362
-				l364:
-					position, tokenIndex = position364, tokenIndex364
+					goto l396
+				l398:
+					position, tokenIndex = position398, tokenIndex398
 				}
 				if !_rules[ruleSpacing]() {
-					goto l362
+					goto l396
 				}
-				add(ruleSERVICE, position363)
+				add(ruleSERVICE, position397)
 			}
 			return true
-		l362:
-			position, tokenIndex = position362, tokenIndex362
+		l396:
+			position, tokenIndex = position396, tokenIndex396
 			return false
 		},
-		/* 63 EXTENDS <- <('e' 'x' 't' 'e' 'n' 'd' 's' !IdChars Spacing)> */
+		/* 65 EXTENDS <- <('e' 'x' 't' 'e' 'n' 'd' 's' !IdChars Spacing)> */
 		func() bool {
-			position365, tokenIndex365 := position, tokenIndex
+			position399, tokenIndex399 := position, tokenIndex
 			{
-				position366 := position
+				position400 := position
 				if buffer[position] != rune('e') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('x') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l365
+					goto l399
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l365
+					goto l399
 				}
 				position++
 				{
-					position367, tokenIndex367 := position, tokenIndex
+					position401, tokenIndex401 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l367
+						goto l401
 					}
-					goto l365
-				l367:
-					position, tokenIndex = position367, tokenIndex367
+					goto l399
+				l401:
+					position, tokenIndex = position401, tokenIndex401
 				}
 				if !_rules[ruleSpacing]() {
-					goto l365
+					goto l399
 				}
-				add(ruleEXTENDS, position366)
+				add(ruleEXTENDS, position400)
 			}
 			return true
-		l365:
-			position, tokenIndex = position365, tokenIndex365
+		l399:
+			position, tokenIndex = position399, tokenIndex399
 			return false
 		},
-		/* 64 EXCEPTION <- <('e' 'x' 'c' 'e' 'p' 't' 'i' 'o' 'n' !IdChars Spacing)> */
+		/* 66 EXCEPTION <- <('e' 'x' 'c' 'e' 'p' 't' 'i' 'o' 'n' !IdChars Spacing)> */
 		func() bool {
-			position368, tokenIndex368 := position, tokenIndex
+			position402, tokenIndex402 := position, tokenIndex
 			{
-				position369 := position
+				position403 := position
 				if buffer[position] != rune('e') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('x') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('c') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l368
+					goto l402
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l368
+					goto l402
 				}
 				position++
 				{
-					position370, tokenIndex370 := position, tokenIndex
+					position404, tokenIndex404 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l370
+						goto l404
 					}
-					goto l368
-				l370:
-					position, tokenIndex = position370, tokenIndex370
+					goto l402
+				l404:
+					position, tokenIndex = position404, tokenIndex404
 				}
 				if !_rules[ruleSpacing]() {
-					goto l368
+					goto l402
 				}
-				add(ruleEXCEPTION, position369)
+				add(ruleEXCEPTION, position403)
 			}
 			return true
-		l368:
-			position, tokenIndex = position368, tokenIndex368
+		l402:
+			position, tokenIndex = position402, tokenIndex402
 			return false
 		},
-		/* 65 ONEWAY <- <('o' 'n' 'e' 'w' 'a' 'y' !IdChars Spacing)> */
+		/* 67 ONEWAY <- <('o' 'n' 'e' 'w' 'a' 'y' !IdChars Spacing)> */
 		func() bool {
-			position371, tokenIndex371 := position, tokenIndex
+			position405, tokenIndex405 := position, tokenIndex
 			{
-				position372 := position
+				position406 := position
 				if buffer[position] != rune('o') {
-					goto l371
+					goto l405
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l371
+					goto l405
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l371
+					goto l405
 				}
 				position++
 				if buffer[position] != rune('w') {
-					goto l371
+					goto l405
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l371
+					goto l405
 				}
 				position++
 				if buffer[position] != rune('y') {
-					goto l371
+					goto l405
 				}
 				position++
 				{
-					position373, tokenIndex373 := position, tokenIndex
+					position407, tokenIndex407 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l373
+						goto l407
 					}
-					goto l371
-				l373:
-					position, tokenIndex = position373, tokenIndex373
+					goto l405
+				l407:
+					position, tokenIndex = position407, tokenIndex407
 				}
 				if !_rules[ruleSpacing]() {
-					goto l371
+					goto l405
 				}
-				add(ruleONEWAY, position372)
+				add(ruleONEWAY, position406)
 			}
 			return true
-		l371:
-			position, tokenIndex = position371, tokenIndex371
+		l405:
+			position, tokenIndex = position405, tokenIndex405
 			return false
 		},
-		/* 66 THROWS <- <('t' 'h' 'r' 'o' 'w' 's' !IdChars Spacing)> */
+		/* 68 THROWS <- <('t' 'h' 'r' 'o' 'w' 's' !IdChars Spacing)> */
 		func() bool {
-			position374, tokenIndex374 := position, tokenIndex
+			position408, tokenIndex408 := position, tokenIndex
 			{
-				position375 := position
+				position409 := position
 				if buffer[position] != rune('t') {
-					goto l374
+					goto l408
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l374
+					goto l408
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l374
+					goto l408
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l374
+					goto l408
 				}
 				position++
 				if buffer[position] != rune('w') {
-					goto l374
+					goto l408
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l374
+					goto l408
 				}
 				position++
 				{
-					position376, tokenIndex376 := position, tokenIndex
+					position410, tokenIndex410 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l376
+						goto l410
 					}
-					goto l374
-				l376:
-					position, tokenIndex = position376, tokenIndex376
+					goto l408
+				l410:
+					position, tokenIndex = position410, tokenIndex410
 				}
 				if !_rules[ruleSpacing]() {
-					goto l374
+					goto l408
 				}
-				add(ruleTHROWS, position375)
+				add(ruleTHROWS, position409)
 			}
 			return true
-		l374:
-			position, tokenIndex = position374, tokenIndex374
+		l408:
+			position, tokenIndex = position408, tokenIndex408
 			return false
 		},
-		/* 67 CPP_TYPE <- <('c' 'p' 'p' '_' 't' 'y' 'p' 'e' !IdChars Spacing)> */
+		/* 69 CPP_TYPE <- <('c' 'p' 'p' '_' 't' 'y' 'p' 'e' !IdChars Spacing)> */
 		func() bool {
-			position377, tokenIndex377 := position, tokenIndex
+			position411, tokenIndex411 := position, tokenIndex
 			{
-				position378 := position
+				position412 := position
 				if buffer[position] != rune('c') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('y') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l377
+					goto l411
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l377
+					goto l411
 				}
 				position++
 				{
-					position379, tokenIndex379 := position, tokenIndex
+					position413, tokenIndex413 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l379
+						goto l413
 					}
-					goto l377
-				l379:
-					position, tokenIndex = position379, tokenIndex379
+					goto l411
+				l413:
+					position, tokenIndex = position413, tokenIndex413
 				}
 				if !_rules[ruleSpacing]() {
-					goto l377
+					goto l411
 				}
-				add(ruleCPP_TYPE, position378)
+				add(ruleCPP_TYPE, position412)
 			}
 			return true
-		l377:
-			position, tokenIndex = position377, tokenIndex377
+		l411:
+			position, tokenIndex = position411, tokenIndex411
 			return false
 		},
-		/* 68 XSD_ALL <- <('x' 's' 'd' '_' 'a' 'l' 'l' !IdChars Spacing)> */
+		/* 70 XSD_ALL <- <('x' 's' 'd' '_' 'a' 'l' 'l' !IdChars Spacing)> */
 		func() bool {
-			position380, tokenIndex380 := position, tokenIndex
+			position414, tokenIndex414 := position, tokenIndex
 			{
-				position381 := position
+				position415 := position
 				if buffer[position] != rune('x') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l380
+					goto l414
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l380
+					goto l414
 				}
 				position++
 				{
-					position382, tokenIndex382 := position, tokenIndex
+					position416, tokenIndex416 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l382
+						goto l416
 					}
-					goto l380
-				l382:
-					position, tokenIndex = position382, tokenIndex382
+					goto l414
+				l416:
+					position, tokenIndex = position416, tokenIndex416
 				}
 				if !_rules[ruleSpacing]() {
-					goto l380
+					goto l414
 				}
-				add(ruleXSD_ALL, position381)
+				add(ruleXSD_ALL, position415)
 			}
 			return true
-		l380:
-			position, tokenIndex = position380, tokenIndex380
+		l414:
+			position, tokenIndex = position414, tokenIndex414
 			return false
 		},
-		/* 69 XSD_OPTIONAL <- <('x' 's' 'd' '_' 'o' 'p' 't' 'i' 'o' 'n' 'a' 'l' !IdChars Spacing)> */
+		/* 71 XSD_OPTIONAL <- <('x' 's' 'd' '_' 'o' 'p' 't' 'i' 'o' 'n' 'a' 'l' !IdChars Spacing)> */
 		func() bool {
-			position383, tokenIndex383 := position, tokenIndex
+			position417, tokenIndex417 := position, tokenIndex
 			{
-				position384 := position
+				position418 := position
 				if buffer[position] != rune('x') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l383
+					goto l417
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l383
+					goto l417
 				}
 				position++
 				{
-					position385, tokenIndex385 := position, tokenIndex
+					position419, tokenIndex419 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l385
+						goto l419
 					}
-					goto l383
-				l385:
-					position, tokenIndex = position385, tokenIndex385
+					goto l417
+				l419:
+					position, tokenIndex = position419, tokenIndex419
 				}
 				if !_rules[ruleSpacing]() {
-					goto l383
+					goto l417
 				}
-				add(ruleXSD_OPTIONAL, position384)
+				add(ruleXSD_OPTIONAL, position418)
 			}
 			return true
-		l383:
-			position, tokenIndex = position383, tokenIndex383
+		l417:
+			position, tokenIndex = position417, tokenIndex417
 			return false
 		},
-		/* 70 XSD_NILLABLE <- <('x' 's' 'd' '_' 'n' 'i' 'l' 'l' 'a' 'b' 'l' 'e' !IdChars Spacing)> */
+		/* 72 XSD_NILLABLE <- <('x' 's' 'd' '_' 'n' 'i' 'l' 'l' 'a' 'b' 'l' 'e' !IdChars Spacing)> */
 		func() bool {
-			position386, tokenIndex386 := position, tokenIndex
+			position420, tokenIndex420 := position, tokenIndex
 			{
-				position387 := position
+				position421 := position
 				if buffer[position] != rune('x') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('b') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l386
+					goto l420
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l386
+					goto l420
 				}
 				position++
 				{
-					position388, tokenIndex388 := position, tokenIndex
+					position422, tokenIndex422 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l388
+						goto l422
 					}
-					goto l386
-				l388:
-					position, tokenIndex = position388, tokenIndex388
+					goto l420
+				l422:
+					position, tokenIndex = position422, tokenIndex422
 				}
 				if !_rules[ruleSpacing]() {
-					goto l386
+					goto l420
 				}
-				add(ruleXSD_NILLABLE, position387)
+				add(ruleXSD_NILLABLE, position421)
 			}
 			return true
-		l386:
-			position, tokenIndex = position386, tokenIndex386
+		l420:
+			position, tokenIndex = position420, tokenIndex420
 			return false
 		},
-		/* 71 XSD_ATTRS <- <('x' 's' 'd' '_' 'a' 't' 't' 'r' 's' !IdChars Spacing)> */
+		/* 73 XSD_ATTRS <- <('x' 's' 'd' '_' 'a' 't' 't' 'r' 's' !IdChars Spacing)> */
 		func() bool {
-			position389, tokenIndex389 := position, tokenIndex
+			position423, tokenIndex423 := position, tokenIndex
 			{
-				position390 := position
+				position424 := position
 				if buffer[position] != rune('x') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('_') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l389
+					goto l423
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l389
+					goto l423
 				}
 				position++
 				{
-					position391, tokenIndex391 := position, tokenIndex
+					position425, tokenIndex425 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l391
+						goto l425
 					}
-					goto l389
-				l391:
-					position, tokenIndex = position391, tokenIndex391
+					goto l423
+				l425:
+					position, tokenIndex = position425, tokenIndex425
 				}
 				if !_rules[ruleSpacing]() {
-					goto l389
+					goto l423
 				}
-				add(ruleXSD_ATTRS, position390)
+				add(ruleXSD_ATTRS, position424)
 			}
 			return true
-		l389:
-			position, tokenIndex = position389, tokenIndex389
+		l423:
+			position, tokenIndex = position423, tokenIndex423
 			return false
 		},
-		/* 72 VOID <- <('v' 'o' 'i' 'd' !IdChars Spacing)> */
+		/* 74 VOID <- <('v' 'o' 'i' 'd' !IdChars Spacing)> */
 		func() bool {
-			position392, tokenIndex392 := position, tokenIndex
+			position426, tokenIndex426 := position, tokenIndex
 			{
-				position393 := position
+				position427 := position
 				if buffer[position] != rune('v') {
-					goto l392
+					goto l426
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l392
+					goto l426
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l392
+					goto l426
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l392
+					goto l426
 				}
 				position++
 				{
-					position394, tokenIndex394 := position, tokenIndex
+					position428, tokenIndex428 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l394
+						goto l428
 					}
-					goto l392
-				l394:
-					position, tokenIndex = position394, tokenIndex394
+					goto l426
+				l428:
+					position, tokenIndex = position428, tokenIndex428
 				}
 				if !_rules[ruleSpacing]() {
-					goto l392
+					goto l426
 				}
-				add(ruleVOID, position393)
+				add(ruleVOID, position427)
 			}
 			return true
-		l392:
-			position, tokenIndex = position392, tokenIndex392
+		l426:
+			position, tokenIndex = position426, tokenIndex426
 			return false
 		},
-		/* 73 MAP <- <('m' 'a' 'p' !IdChars Spacing)> */
+		/* 75 MAP <- <('m' 'a' 'p' !IdChars Spacing)> */
 		func() bool {
-			position395, tokenIndex395 := position, tokenIndex
+			position429, tokenIndex429 := position, tokenIndex
 			{
-				position396 := position
+				position430 := position
 				if buffer[position] != rune('m') {
-					goto l395
+					goto l429
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l395
+					goto l429
 				}
 				position++
 				if buffer[position] != rune('p') {
-					goto l395
+					goto l429
 				}
 				position++
 				{
-					position397, tokenIndex397 := position, tokenIndex
+					position431, tokenIndex431 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l397
+						goto l431
 					}
-					goto l395
-				l397:
-					position, tokenIndex = position397, tokenIndex397
+					goto l429
+				l431:
+					position, tokenIndex = position431, tokenIndex431
 				}
 				if !_rules[ruleSpacing]() {
-					goto l395
+					goto l429
 				}
-				add(ruleMAP, position396)
+				add(ruleMAP, position430)
 			}
 			return true
-		l395:
-			position, tokenIndex = position395, tokenIndex395
+		l429:
+			position, tokenIndex = position429, tokenIndex429
 			return false
 		},
-		/* 74 SET <- <('s' 'e' 't' !IdChars Spacing)> */
+		/* 76 SET <- <('s' 'e' 't' !IdChars Spacing)> */
 		func() bool {
-			position398, tokenIndex398 := position, tokenIndex
+			position432, tokenIndex432 := position, tokenIndex
 			{
-				position399 := position
+				position433 := position
 				if buffer[position] != rune('s') {
-					goto l398
+					goto l432
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l398
+					goto l432
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l398
+					goto l432
 				}
 				position++
 				{
-					position400, tokenIndex400 := position, tokenIndex
+					position434, tokenIndex434 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l400
+						goto l434
 					}
-					goto l398
-				l400:
-					position, tokenIndex = position400, tokenIndex400
+					goto l432
+				l434:
+					position, tokenIndex = position434, tokenIndex434
 				}
 				if !_rules[ruleSpacing]() {
-					goto l398
+					goto l432
 				}
-				add(ruleSET, position399)
+				add(ruleSET, position433)
 			}
 			return true
-		l398:
-			position, tokenIndex = position398, tokenIndex398
+		l432:
+			position, tokenIndex = position432, tokenIndex432
 			return false
 		},
-		/* 75 LIST <- <('l' 'i' 's' 't' !IdChars Spacing)> */
+		/* 77 LIST <- <('l' 'i' 's' 't' !IdChars Spacing)> */
 		func() bool {
-			position401, tokenIndex401 := position, tokenIndex
+			position435, tokenIndex435 := position, tokenIndex
 			{
-				position402 := position
+				position436 := position
 				if buffer[position] != rune('l') {
-					goto l401
+					goto l435
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l401
+					goto l435
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l401
+					goto l435
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l401
+					goto l435
 				}
 				position++
 				{
-					position403, tokenIndex403 := position, tokenIndex
+					position437, tokenIndex437 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l403
+						goto l437
 					}
-					goto l401
-				l403:
-					position, tokenIndex = position403, tokenIndex403
+					goto l435
+				l437:
+					position, tokenIndex = position437, tokenIndex437
 				}
 				if !_rules[ruleSpacing]() {
-					goto l401
+					goto l435
 				}
-				add(ruleLIST, position402)
+				add(ruleLIST, position436)
 			}
 			return true
-		l401:
-			position, tokenIndex = position401, tokenIndex401
+		l435:
+			position, tokenIndex = position435, tokenIndex435
 			return false
 		},
-		/* 76 BOOL <- <(<('b' 'o' 'o' 'l')> !IdChars Spacing)> */
+		/* 78 BOOL <- <(<('b' 'o' 'o' 'l')> !IdChars Spacing)> */
 		func() bool {
-			position404, tokenIndex404 := position, tokenIndex
+			position438, tokenIndex438 := position, tokenIndex
 			{
-				position405 := position
+				position439 := position
 				{
-					position406 := position
+					position440 := position
 					if buffer[position] != rune('b') {
-						goto l404
+						goto l438
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l404
+						goto l438
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l404
+						goto l438
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l404
+						goto l438
 					}
 					position++
-					add(rulePegText, position406)
+					add(rulePegText, position440)
 				}
 				{
-					position407, tokenIndex407 := position, tokenIndex
+					position441, tokenIndex441 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l407
+						goto l441
 					}
-					goto l404
-				l407:
-					position, tokenIndex = position407, tokenIndex407
+					goto l438
+				l441:
+					position, tokenIndex = position441, tokenIndex441
 				}
 				if !_rules[ruleSpacing]() {
-					goto l404
+					goto l438
 				}
-				add(ruleBOOL, position405)
+				add(ruleBOOL, position439)
 			}
 			return true
-		l404:
-			position, tokenIndex = position404, tokenIndex404
+		l438:
+			position, tokenIndex = position438, tokenIndex438
 			return false
 		},
-		/* 77 BYTE <- <(<('b' 'y' 't' 'e')> !IdChars Spacing)> */
+		/* 79 BYTE <- <(<('b' 'y' 't' 'e')> !IdChars Spacing)> */
 		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
+			position442, tokenIndex442 := position, tokenIndex
 			{
-				position409 := position
+				position443 := position
 				{
-					position410 := position
+					position444 := position
 					if buffer[position] != rune('b') {
-						goto l408
+						goto l442
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l408
+						goto l442
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l408
+						goto l442
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l408
+						goto l442
 					}
 					position++
-					add(rulePegText, position410)
+					add(rulePegText, position444)
 				}
 				{
-					position411, tokenIndex411 := position, tokenIndex
+					position445, tokenIndex445 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l411
+						goto l445
 					}
-					goto l408
-				l411:
-					position, tokenIndex = position411, tokenIndex411
+					goto l442
+				l445:
+					position, tokenIndex = position445, tokenIndex445
 				}
 				if !_rules[ruleSpacing]() {
-					goto l408
+					goto l442
 				}
-				add(ruleBYTE, position409)
+				add(ruleBYTE, position443)
 			}
 			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
+		l442:
+			position, tokenIndex = position442, tokenIndex442
 			return false
 		},
-		/* 78 I8 <- <(<('i' '8')> !IdChars Spacing)> */
+		/* 80 I8 <- <(<('i' '8')> !IdChars Spacing)> */
 		func() bool {
-			position412, tokenIndex412 := position, tokenIndex
+			position446, tokenIndex446 := position, tokenIndex
 			{
-				position413 := position
+				position447 := position
 				{
-					position414 := position
+					position448 := position
 					if buffer[position] != rune('i') {
-						goto l412
+						goto l446
 					}
 					position++
 					if buffer[position] != rune('8') {
-						goto l412
+						goto l446
 					}
 					position++
-					add(rulePegText, position414)
+					add(rulePegText, position448)
 				}
 				{
-					position415, tokenIndex415 := position, tokenIndex
+					position449, tokenIndex449 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l415
+						goto l449
 					}
-					goto l412
-				l415:
-					position, tokenIndex = position415, tokenIndex415
+					goto l446
+				l449:
+					position, tokenIndex = position449, tokenIndex449
 				}
 				if !_rules[ruleSpacing]() {
-					goto l412
+					goto l446
 				}
-				add(ruleI8, position413)
+				add(ruleI8, position447)
 			}
 			return true
-		l412:
-			position, tokenIndex = position412, tokenIndex412
+		l446:
+			position, tokenIndex = position446, tokenIndex446
 			return false
 		},
-		/* 79 I16 <- <(<('i' '1' '6')> !IdChars Spacing)> */
+		/* 81 I16 <- <(<('i' '1' '6')> !IdChars Spacing)> */
 		func() bool {
-			position416, tokenIndex416 := position, tokenIndex
+			position450, tokenIndex450 := position, tokenIndex
 			{
-				position417 := position
+				position451 := position
 				{
-					position418 := position
+					position452 := position
 					if buffer[position] != rune('i') {
-						goto l416
+						goto l450
 					}
 					position++
 					if buffer[position] != rune('1') {
-						goto l416
+						goto l450
 					}
 					position++
 					if buffer[position] != rune('6') {
-						goto l416
+						goto l450
 					}
 					position++
-					add(rulePegText, position418)
+					add(rulePegText, position452)
 				}
 				{
-					position419, tokenIndex419 := position, tokenIndex
+					position453, tokenIndex453 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l419
+						goto l453
 					}
-					goto l416
-				l419:
-					position, tokenIndex = position419, tokenIndex419
+					goto l450
+				l453:
+					position, tokenIndex = position453, tokenIndex453
 				}
 				if !_rules[ruleSpacing]() {
-					goto l416
+					goto l450
 				}
-				add(ruleI16, position417)
+				add(ruleI16, position451)
 			}
 			return true
-		l416:
-			position, tokenIndex = position416, tokenIndex416
+		l450:
+			position, tokenIndex = position450, tokenIndex450
 			return false
 		},
-		/* 80 I32 <- <(<('i' '3' '2')> !IdChars Spacing)> */
+		/* 82 I32 <- <(<('i' '3' '2')> !IdChars Spacing)> */
 		func() bool {
-			position420, tokenIndex420 := position, tokenIndex
+			position454, tokenIndex454 := position, tokenIndex
 			{
-				position421 := position
+				position455 := position
 				{
-					position422 := position
+					position456 := position
 					if buffer[position] != rune('i') {
-						goto l420
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('3') {
-						goto l420
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('2') {
-						goto l420
+						goto l454
 					}
 					position++
-					add(rulePegText, position422)
+					add(rulePegText, position456)
 				}
 				{
-					position423, tokenIndex423 := position, tokenIndex
+					position457, tokenIndex457 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l423
+						goto l457
 					}
-					goto l420
-				l423:
-					position, tokenIndex = position423, tokenIndex423
+					goto l454
+				l457:
+					position, tokenIndex = position457, tokenIndex457
 				}
 				if !_rules[ruleSpacing]() {
-					goto l420
+					goto l454
 				}
-				add(ruleI32, position421)
+				add(ruleI32, position455)
 			}
 			return true
-		l420:
-			position, tokenIndex = position420, tokenIndex420
+		l454:
+			position, tokenIndex = position454, tokenIndex454
 			return false
 		},
-		/* 81 I64 <- <(<('i' '6' '4')> !IdChars Spacing)> */
+		/* 83 I64 <- <(<('i' '6' '4')> !IdChars Spacing)> */
 		func() bool {
-			position424, tokenIndex424 := position, tokenIndex
+			position458, tokenIndex458 := position, tokenIndex
 			{
-				position425 := position
+				position459 := position
 				{
-					position426 := position
+					position460 := position
 					if buffer[position] != rune('i') {
-						goto l424
+						goto l458
 					}
 					position++
 					if buffer[position] != rune('6') {
-						goto l424
+						goto l458
 					}
 					position++
 					if buffer[position] != rune('4') {
-						goto l424
+						goto l458
 					}
 					position++
-					add(rulePegText, position426)
+					add(rulePegText, position460)
 				}
 				{
-					position427, tokenIndex427 := position, tokenIndex
+					position461, tokenIndex461 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l427
+						goto l461
 					}
-					goto l424
-				l427:
-					position, tokenIndex = position427, tokenIndex427
+					goto l458
+				l461:
+					position, tokenIndex = position461, tokenIndex461
 				}
 				if !_rules[ruleSpacing]() {
-					goto l424
+					goto l458
 				}
-				add(ruleI64, position425)
+				add(ruleI64, position459)
 			}
 			return true
-		l424:
-			position, tokenIndex = position424, tokenIndex424
+		l458:
+			position, tokenIndex = position458, tokenIndex458
 			return false
 		},
-		/* 82 DOUBLE <- <(<('d' 'o' 'u' 'b' 'l' 'e')> !IdChars Spacing)> */
+		/* 84 DOUBLE <- <(<('d' 'o' 'u' 'b' 'l' 'e')> !IdChars Spacing)> */
 		func() bool {
-			position428, tokenIndex428 := position, tokenIndex
+			position462, tokenIndex462 := position, tokenIndex
 			{
-				position429 := position
+				position463 := position
 				{
-					position430 := position
+					position464 := position
 					if buffer[position] != rune('d') {
-						goto l428
+						goto l462
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l428
+						goto l462
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l428
+						goto l462
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l428
+						goto l462
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l428
+						goto l462
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l428
+						goto l462
 					}
 					position++
-					add(rulePegText, position430)
+					add(rulePegText, position464)
 				}
 				{
-					position431, tokenIndex431 := position, tokenIndex
+					position465, tokenIndex465 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l431
+						goto l465
 					}
-					goto l428
-				l431:
-					position, tokenIndex = position431, tokenIndex431
+					goto l462
+				l465:
+					position, tokenIndex = position465, tokenIndex465
 				}
 				if !_rules[ruleSpacing]() {
-					goto l428
+					goto l462
 				}
-				add(ruleDOUBLE, position429)
+				add(ruleDOUBLE, position463)
 			}
 			return true
-		l428:
-			position, tokenIndex = position428, tokenIndex428
+		l462:
+			position, tokenIndex = position462, tokenIndex462
 			return false
 		},
-		/* 83 STRING <- <(<('s' 't' 'r' 'i' 'n' 'g')> !IdChars Spacing)> */
+		/* 85 STRING <- <(<('s' 't' 'r' 'i' 'n' 'g')> !IdChars Spacing)> */
 		func() bool {
-			position432, tokenIndex432 := position, tokenIndex
+			position466, tokenIndex466 := position, tokenIndex
 			{
-				position433 := position
+				position467 := position
 				{
-					position434 := position
+					position468 := position
 					if buffer[position] != rune('s') {
-						goto l432
+						goto l466
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l432
+						goto l466
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l432
+						goto l466
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l432
+						goto l466
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l432
+						goto l466
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l432
+						goto l466
 					}
 					position++
-					add(rulePegText, position434)
+					add(rulePegText, position468)
 				}
 				{
-					position435, tokenIndex435 := position, tokenIndex
+					position469, tokenIndex469 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l435
+						goto l469
 					}
-					goto l432
-				l435:
-					position, tokenIndex = position435, tokenIndex435
+					goto l466
+				l469:
+					position, tokenIndex = position469, tokenIndex469
 				}
 				if !_rules[ruleSpacing]() {
-					goto l432
+					goto l466
 				}
-				add(ruleSTRING, position433)
+				add(ruleSTRING, position467)
 			}
 			return true
-		l432:
-			position, tokenIndex = position432, tokenIndex432
+		l466:
+			position, tokenIndex = position466, tokenIndex466
 			return false
 		},
-		/* 84 BINARY <- <(<('b' 'i' 'n' 'a' 'r' 'y')> !IdChars Spacing)> */
+		/* 86 BINARY <- <(<('b' 'i' 'n' 'a' 'r' 'y')> !IdChars Spacing)> */
 		func() bool {
-			position436, tokenIndex436 := position, tokenIndex
+			position470, tokenIndex470 := position, tokenIndex
 			{
-				position437 := position
+				position471 := position
 				{
-					position438 := position
+					position472 := position
 					if buffer[position] != rune('b') {
-						goto l436
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l436
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l436
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l436
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l436
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l436
+						goto l470
 					}
 					position++
-					add(rulePegText, position438)
+					add(rulePegText, position472)
 				}
 				{
-					position439, tokenIndex439 := position, tokenIndex
+					position473, tokenIndex473 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l439
+						goto l473
 					}
-					goto l436
-				l439:
-					position, tokenIndex = position439, tokenIndex439
+					goto l470
+				l473:
+					position, tokenIndex = position473, tokenIndex473
 				}
 				if !_rules[ruleSpacing]() {
-					goto l436
+					goto l470
 				}
-				add(ruleBINARY, position437)
+				add(ruleBINARY, position471)
 			}
 			return true
-		l436:
-			position, tokenIndex = position436, tokenIndex436
+		l470:
+			position, tokenIndex = position470, tokenIndex470
 			return false
 		},
-		/* 85 SLIST <- <(<('s' 'l' 'i' 's' 't')> !IdChars Spacing)> */
+		/* 87 SLIST <- <(<('s' 'l' 'i' 's' 't')> !IdChars Spacing)> */
 		func() bool {
-			position440, tokenIndex440 := position, tokenIndex
+			position474, tokenIndex474 := position, tokenIndex
 			{
-				position441 := position
+				position475 := position
 				{
-					position442 := position
+					position476 := position
 					if buffer[position] != rune('s') {
-						goto l440
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l440
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l440
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l440
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l440
+						goto l474
 					}
 					position++
-					add(rulePegText, position442)
+					add(rulePegText, position476)
 				}
 				{
-					position443, tokenIndex443 := position, tokenIndex
+					position477, tokenIndex477 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l443
+						goto l477
 					}
-					goto l440
-				l443:
-					position, tokenIndex = position443, tokenIndex443
+					goto l474
+				l477:
+					position, tokenIndex = position477, tokenIndex477
 				}
 				if !_rules[ruleSpacing]() {
-					goto l440
+					goto l474
 				}
-				add(ruleSLIST, position441)
+				add(ruleSLIST, position475)
 			}
 			return true
-		l440:
-			position, tokenIndex = position440, tokenIndex440
+		l474:
+			position, tokenIndex = position474, tokenIndex474
 			return false
 		},
-		/* 86 FLOAT <- <(<('f' 'l' 'o' 'a' 't')> !IdChars Spacing)> */
+		/* 88 FLOAT <- <(<('f' 'l' 'o' 'a' 't')> !IdChars Spacing)> */
 		func() bool {
-			position444, tokenIndex444 := position, tokenIndex
+			position478, tokenIndex478 := position, tokenIndex
 			{
-				position445 := position
+				position479 := position
 				{
-					position446 := position
+					position480 := position
 					if buffer[position] != rune('f') {
-						goto l444
+						goto l478
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l444
+						goto l478
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l444
+						goto l478
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l444
+						goto l478
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l444
+						goto l478
 					}
 					position++
-					add(rulePegText, position446)
+					add(rulePegText, position480)
 				}
 				{
-					position447, tokenIndex447 := position, tokenIndex
+					position481, tokenIndex481 := position, tokenIndex
 					if !_rules[ruleIdChars]() {
-						goto l447
+						goto l481
 					}
-					goto l444
-				l447:
-					position, tokenIndex = position447, tokenIndex447
+					goto l478
+				l481:
+					position, tokenIndex = position481, tokenIndex481
 				}
 				if !_rules[ruleSpacing]() {
-					goto l444
+					goto l478
 				}
-				add(ruleFLOAT, position445)
+				add(ruleFLOAT, position479)
 			}
 			return true
-		l444:
-			position, tokenIndex = position444, tokenIndex444
+		l478:
+			position, tokenIndex = position478, tokenIndex478
 			return false
 		},
-		/* 87 LBRK <- <('[' Spacing)> */
+		/* 89 LBRK <- <('[' Spacing)> */
 		func() bool {
-			position448, tokenIndex448 := position, tokenIndex
+			position482, tokenIndex482 := position, tokenIndex
 			{
-				position449 := position
+				position483 := position
 				if buffer[position] != rune('[') {
-					goto l448
+					goto l482
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l448
+					goto l482
 				}
-				add(ruleLBRK, position449)
+				add(ruleLBRK, position483)
 			}
 			return true
-		l448:
-			position, tokenIndex = position448, tokenIndex448
+		l482:
+			position, tokenIndex = position482, tokenIndex482
 			return false
 		},
-		/* 88 RBRK <- <(']' Spacing)> */
+		/* 90 RBRK <- <(']' Spacing)> */
 		func() bool {
-			position450, tokenIndex450 := position, tokenIndex
+			position484, tokenIndex484 := position, tokenIndex
 			{
-				position451 := position
+				position485 := position
 				if buffer[position] != rune(']') {
-					goto l450
+					goto l484
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l450
+					goto l484
 				}
-				add(ruleRBRK, position451)
+				add(ruleRBRK, position485)
 			}
 			return true
-		l450:
-			position, tokenIndex = position450, tokenIndex450
+		l484:
+			position, tokenIndex = position484, tokenIndex484
 			return false
 		},
-		/* 89 LPAR <- <('(' Spacing)> */
+		/* 91 LPAR <- <('(' Spacing)> */
 		func() bool {
-			position452, tokenIndex452 := position, tokenIndex
+			position486, tokenIndex486 := position, tokenIndex
 			{
-				position453 := position
+				position487 := position
 				if buffer[position] != rune('(') {
-					goto l452
+					goto l486
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l452
+					goto l486
 				}
-				add(ruleLPAR, position453)
+				add(ruleLPAR, position487)
 			}
 			return true
-		l452:
-			position, tokenIndex = position452, tokenIndex452
+		l486:
+			position, tokenIndex = position486, tokenIndex486
 			return false
 		},
-		/* 90 RPAR <- <(')' Spacing)> */
+		/* 92 RPAR <- <(')' Spacing)> */
 		func() bool {
-			position454, tokenIndex454 := position, tokenIndex
+			position488, tokenIndex488 := position, tokenIndex
 			{
-				position455 := position
+				position489 := position
 				if buffer[position] != rune(')') {
-					goto l454
+					goto l488
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l454
+					goto l488
 				}
-				add(ruleRPAR, position455)
+				add(ruleRPAR, position489)
 			}
 			return true
-		l454:
-			position, tokenIndex = position454, tokenIndex454
+		l488:
+			position, tokenIndex = position488, tokenIndex488
 			return false
 		},
-		/* 91 LWING <- <('{' Spacing)> */
+		/* 93 LWING <- <('{' Spacing)> */
 		func() bool {
-			position456, tokenIndex456 := position, tokenIndex
+			position490, tokenIndex490 := position, tokenIndex
 			{
-				position457 := position
+				position491 := position
 				if buffer[position] != rune('{') {
-					goto l456
+					goto l490
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l456
+					goto l490
 				}
-				add(ruleLWING, position457)
+				add(ruleLWING, position491)
 			}
 			return true
-		l456:
-			position, tokenIndex = position456, tokenIndex456
+		l490:
+			position, tokenIndex = position490, tokenIndex490
 			return false
 		},
-		/* 92 RWING <- <('}' Spacing)> */
+		/* 94 RWING <- <('}' Spacing)> */
 		func() bool {
-			position458, tokenIndex458 := position, tokenIndex
+			position492, tokenIndex492 := position, tokenIndex
 			{
-				position459 := position
+				position493 := position
 				if buffer[position] != rune('}') {
-					goto l458
+					goto l492
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l458
+					goto l492
 				}
-				add(ruleRWING, position459)
+				add(ruleRWING, position493)
 			}
 			return true
-		l458:
-			position, tokenIndex = position458, tokenIndex458
+		l492:
+			position, tokenIndex = position492, tokenIndex492
 			return false
 		},
-		/* 93 LPOINT <- <('<' Spacing)> */
+		/* 95 LPOINT <- <('<' Spacing)> */
 		func() bool {
-			position460, tokenIndex460 := position, tokenIndex
+			position494, tokenIndex494 := position, tokenIndex
 			{
-				position461 := position
+				position495 := position
 				if buffer[position] != rune('<') {
-					goto l460
+					goto l494
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l460
+					goto l494
 				}
-				add(ruleLPOINT, position461)
+				add(ruleLPOINT, position495)
 			}
 			return true
-		l460:
-			position, tokenIndex = position460, tokenIndex460
+		l494:
+			position, tokenIndex = position494, tokenIndex494
 			return false
 		},
-		/* 94 RPOINT <- <('>' Spacing)> */
+		/* 96 RPOINT <- <('>' Spacing)> */
 		func() bool {
-			position462, tokenIndex462 := position, tokenIndex
+			position496, tokenIndex496 := position, tokenIndex
 			{
-				position463 := position
+				position497 := position
 				if buffer[position] != rune('>') {
-					goto l462
+					goto l496
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l462
+					goto l496
 				}
-				add(ruleRPOINT, position463)
+				add(ruleRPOINT, position497)
 			}
 			return true
-		l462:
-			position, tokenIndex = position462, tokenIndex462
+		l496:
+			position, tokenIndex = position496, tokenIndex496
 			return false
 		},
-		/* 95 EQUAL <- <('=' !'=' Spacing)> */
+		/* 97 EQUAL <- <('=' !'=' Spacing)> */
 		func() bool {
-			position464, tokenIndex464 := position, tokenIndex
+			position498, tokenIndex498 := position, tokenIndex
 			{
-				position465 := position
+				position499 := position
 				if buffer[position] != rune('=') {
-					goto l464
+					goto l498
 				}
 				position++
 				{
-					position466, tokenIndex466 := position, tokenIndex
+					position500, tokenIndex500 := position, tokenIndex
 					if buffer[position] != rune('=') {
-						goto l466
+						goto l500
 					}
 					position++
-					goto l464
-				l466:
-					position, tokenIndex = position466, tokenIndex466
+					goto l498
+				l500:
+					position, tokenIndex = position500, tokenIndex500
 				}
 				if !_rules[ruleSpacing]() {
-					goto l464
+					goto l498
 				}
-				add(ruleEQUAL, position465)
+				add(ruleEQUAL, position499)
 			}
 			return true
-		l464:
-			position, tokenIndex = position464, tokenIndex464
+		l498:
+			position, tokenIndex = position498, tokenIndex498
 			return false
 		},
-		/* 96 COMMA <- <(',' Spacing)> */
+		/* 98 COMMA <- <(',' Spacing)> */
 		func() bool {
-			position467, tokenIndex467 := position, tokenIndex
+			position501, tokenIndex501 := position, tokenIndex
 			{
-				position468 := position
+				position502 := position
 				if buffer[position] != rune(',') {
-					goto l467
+					goto l501
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l467
+					goto l501
 				}
-				add(ruleCOMMA, position468)
+				add(ruleCOMMA, position502)
 			}
 			return true
-		l467:
-			position, tokenIndex = position467, tokenIndex467
+		l501:
+			position, tokenIndex = position501, tokenIndex501
 			return false
 		},
-		/* 97 COLON <- <(':' Spacing)> */
+		/* 99 COLON <- <(':' Spacing)> */
 		func() bool {
-			position469, tokenIndex469 := position, tokenIndex
+			position503, tokenIndex503 := position, tokenIndex
 			{
-				position470 := position
+				position504 := position
 				if buffer[position] != rune(':') {
-					goto l469
+					goto l503
 				}
 				position++
 				if !_rules[ruleSpacing]() {
-					goto l469
+					goto l503
 				}
-				add(ruleCOLON, position470)
+				add(ruleCOLON, position504)
 			}
 			return true
-		l469:
-			position, tokenIndex = position469, tokenIndex469
+		l503:
+			position, tokenIndex = position503, tokenIndex503
 			return false
 		},
-		/* 98 EOT <- <!.> */
+		/* 100 EOT <- <!.> */
 		func() bool {
-			position471, tokenIndex471 := position, tokenIndex
+			position505, tokenIndex505 := position, tokenIndex
 			{
-				position472 := position
+				position506 := position
 				{
-					position473, tokenIndex473 := position, tokenIndex
+					position507, tokenIndex507 := position, tokenIndex
 					if !matchDot() {
-						goto l473
+						goto l507
 					}
-					goto l471
-				l473:
-					position, tokenIndex = position473, tokenIndex473
+					goto l505
+				l507:
+					position, tokenIndex = position507, tokenIndex507
 				}
-				add(ruleEOT, position472)
+				add(ruleEOT, position506)
 			}
 			return true
-		l471:
-			position, tokenIndex = position471, tokenIndex471
+		l505:
+			position, tokenIndex = position505, tokenIndex505
 			return false
 		},
 		nil,
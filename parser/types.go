@@ -52,6 +52,20 @@ func (t *Type) TType() TType {
 	return ToTType(t.Name)
 }
 
+// IsValueType tells whether t is a base type except binary or an enum, or
+// a typedef of them, which are not reference types in Go. For generator.
+func (t *Type) IsValueType() bool {
+	switch x := t.GetFinalType().(type) {
+	case nil:
+		return t.Category == TypeBasic && t.TType() != BINARY
+	case *Type:
+		return x.Category == TypeBasic && x.TType() != BINARY
+	case *Enum:
+		return true
+	}
+	return false
+}
+
 func (t *Type) GetFinalType() interface{} {
 	if t.FinalType == nil {
 		if t.D != nil && t.D.IdentTypes != nil {
@@ -183,11 +197,11 @@ type Struct struct {
 // TODO: move the methods of Struct and Union to generator package.
 
 // DefaultFields returns fields which have default values, excluding
-// fields with container type. For generator.
+// fields with container or struct type. For generator.
 func (s *Struct) DefaultFields() []*Field {
 	fields := make([]*Field, 0)
 	for _, f := range s.Fields {
-		if f.Default != nil && f.Type.Category != TypeContainer {
+		if f.Default != nil && f.Type.IsValueType() {
 			fields = append(fields, f)
 		}
 	}
@@ -246,6 +260,17 @@ type Annotation struct {
 	Value string
 }
 
+// GetAnnotation returns value of the named annotation and whether it
+// presents in annotations.
+func GetAnnotation(annotations []*Annotation, name string) (string, bool) {
+	for _, a := range annotations {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
 type Document struct {
 	Filename string
 	RefName  string